@@ -0,0 +1,157 @@
+package disgomsg
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Ranks for how closely a choice matches the autocomplete input, from best to worst.
+const (
+	matchExact = iota
+	matchPrefix
+	matchSubstring
+	matchFuzzy
+	matchNone
+)
+
+// Autocomplete ranks candidate choices against the partial input of the focused option of an autocomplete
+// interaction, and sends the best matches as the response.
+type Autocomplete struct {
+	input      string
+	candidates []*discordgo.ApplicationCommandOptionChoice
+}
+
+// NewAutocomplete creates a new autocomplete helper for the partial input and candidate choices.
+func NewAutocomplete(input string, candidates []*discordgo.ApplicationCommandOptionChoice) *Autocomplete {
+	return &Autocomplete{input: input, candidates: candidates}
+}
+
+// NewAutocompleteStrings creates a new autocomplete helper for the partial input, using each of the candidate
+// strings as both the name and the value of a choice.
+func NewAutocompleteStrings(input string, candidates []string) *Autocomplete {
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(candidates))
+	for _, c := range candidates {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: c, Value: c})
+	}
+	return NewAutocomplete(input, choices)
+}
+
+// Choices returns the candidates that match the input, ranked with exact matches first, followed by prefix,
+// substring and fuzzy matches. Matching is case-insensitive. At most MaxChoices are returned, and names longer
+// than Discord's limit are truncated.
+func (a *Autocomplete) Choices() []*discordgo.ApplicationCommandOptionChoice {
+	input := strings.ToLower(strings.TrimSpace(a.input))
+
+	type ranked struct {
+		choice *discordgo.ApplicationCommandOptionChoice
+		rank   int
+		score  int
+	}
+	matches := make([]ranked, 0, len(a.candidates))
+	for _, c := range a.candidates {
+		if c == nil {
+			continue
+		}
+		rank, score := matchChoice(input, strings.ToLower(c.Name))
+		if rank != matchNone {
+			matches = append(matches, ranked{choice: c, rank: rank, score: score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].rank != matches[j].rank {
+			return matches[i].rank < matches[j].rank
+		}
+		return matches[i].score < matches[j].score
+	})
+
+	if len(matches) > MaxChoices {
+		matches = matches[:MaxChoices]
+	}
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(matches))
+	for _, m := range matches {
+		choice := *m.choice
+		choice.Name = truncate(choice.Name, MaxChoiceNameLength)
+		choices = append(choices, &choice)
+	}
+	return choices
+}
+
+// Send sends the ranked choices as the response to the autocomplete interaction.
+func (a *Autocomplete) Send(s Sender, i *discordgo.Interaction, options ...discordgo.RequestOption) error {
+	return a.SendContext(context.Background(), s, i, options...)
+}
+
+// SendContext is like Send, but the requests are bound to the context and any pending retries are abandoned once the
+// context is done.
+func (a *Autocomplete) SendContext(ctx context.Context, s Sender, i *discordgo.Interaction, options ...discordgo.RequestOption) error {
+	responseType := discordgo.InteractionApplicationCommandAutocompleteResult
+	return NewResponse(
+		WithResponseType(&responseType),
+		WithChoices(a.Choices()),
+	).SendContext(ctx, s, i, options...)
+}
+
+// FocusedOption returns the option the user is typing in for an autocomplete interaction, searching any
+// subcommands and subcommand groups.
+func FocusedOption(i *discordgo.Interaction) (*discordgo.ApplicationCommandInteractionDataOption, bool) {
+	if i == nil || i.Type != discordgo.InteractionApplicationCommandAutocomplete {
+		return nil, false
+	}
+	return focusedOption(i.ApplicationCommandData().Options)
+}
+
+// focusedOption returns the focused option from the options or their nested options.
+func focusedOption(options []*discordgo.ApplicationCommandInteractionDataOption) (*discordgo.ApplicationCommandInteractionDataOption, bool) {
+	for _, opt := range options {
+		if opt.Focused {
+			return opt, true
+		}
+		if focused, ok := focusedOption(opt.Options); ok {
+			return focused, true
+		}
+	}
+	return nil, false
+}
+
+// matchChoice returns the rank of the match between the lowercase input and name, along with a score used to
+// order matches of the same rank, where lower is better.
+func matchChoice(input, name string) (rank int, score int) {
+	switch {
+	case input == "":
+		return matchPrefix, 0
+	case name == input:
+		return matchExact, 0
+	case strings.HasPrefix(name, input):
+		return matchPrefix, utf8.RuneCountInString(name)
+	}
+	if i := strings.Index(name, input); i >= 0 {
+		return matchSubstring, i
+	}
+	if gaps, ok := fuzzyMatch(input, name); ok {
+		return matchFuzzy, gaps
+	}
+	return matchNone, 0
+}
+
+// fuzzyMatch reports whether every character of the input appears in the name in order, returning the number of
+// characters skipped between the first and last matched characters.
+func fuzzyMatch(input, name string) (gaps int, ok bool) {
+	remaining := []rune(input)
+	started := false
+	for _, r := range name {
+		if len(remaining) == 0 {
+			break
+		}
+		if r == remaining[0] {
+			remaining = remaining[1:]
+			started = true
+		} else if started {
+			gaps++
+		}
+	}
+	return gaps, len(remaining) == 0
+}
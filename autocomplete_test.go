@@ -0,0 +1,106 @@
+package disgomsg
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// choiceNames returns the names of the choices.
+func choiceNames(choices []*discordgo.ApplicationCommandOptionChoice) []string {
+	names := make([]string, 0, len(choices))
+	for _, c := range choices {
+		names = append(names, c.Name)
+	}
+	return names
+}
+
+func TestAutocompleteRanking(t *testing.T) {
+	candidates := []string{"Blueberry", "Strawberry", "Apple", "apple pie", "Pineapple", "Grape", "Apricot"}
+	choices := NewAutocompleteStrings("APP", candidates).Choices()
+	expected := []string{"Apple", "apple pie", "Pineapple"}
+	if got := choiceNames(choices); strings.Join(got, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	choices = NewAutocompleteStrings("apple", candidates).Choices()
+	if choices[0].Name != "Apple" {
+		t.Errorf("Expected exact match first, got %v", choiceNames(choices))
+	}
+
+	choices = NewAutocompleteStrings("bry", candidates).Choices()
+	expected = []string{"Strawberry", "Blueberry"}
+	if got := choiceNames(choices); strings.Join(got, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected fuzzy matches %v, got %v", expected, got)
+	}
+}
+
+func TestAutocompleteEmptyInput(t *testing.T) {
+	candidates := []string{"b", "a", "c"}
+	choices := NewAutocompleteStrings("", candidates).Choices()
+	if got := choiceNames(choices); strings.Join(got, ",") != "b,a,c" {
+		t.Errorf("Expected original order, got %v", got)
+	}
+}
+
+func TestAutocompleteLimits(t *testing.T) {
+	var candidates []string
+	for i := 0; i < 40; i++ {
+		candidates = append(candidates, fmt.Sprintf("item %02d %s", i, strings.Repeat("x", MaxChoiceNameLength)))
+	}
+	choices := NewAutocompleteStrings("item", candidates).Choices()
+	if len(choices) != MaxChoices {
+		t.Fatalf("Expected %d choices, got %d", MaxChoices, len(choices))
+	}
+	for _, c := range choices {
+		if n := utf8.RuneCountInString(c.Name); n > MaxChoiceNameLength {
+			t.Errorf("Expected name length at most %d, got %d", MaxChoiceNameLength, n)
+		}
+	}
+	if candidates[0] == choices[0].Name {
+		t.Error("Expected choice name to be truncated")
+	}
+	if choices[0].Value != candidates[0] {
+		t.Error("Expected choice value to be unchanged")
+	}
+}
+
+func TestAutocompleteSend(t *testing.T) {
+	rec := NewRecorder()
+	interaction := &discordgo.Interaction{ID: "interaction-1"}
+	if err := NewAutocompleteStrings("a", []string{"a", "b"}).Send(rec, interaction); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	req, _ := rec.Last()
+	if req.InteractionResponse.Type != discordgo.InteractionApplicationCommandAutocompleteResult {
+		t.Errorf("Expected autocomplete result type, got %v", req.InteractionResponse.Type)
+	}
+	if len(req.InteractionResponse.Data.Choices) != 1 {
+		t.Errorf("Expected 1 choice, got %d", len(req.InteractionResponse.Data.Choices))
+	}
+}
+
+func TestFocusedOption(t *testing.T) {
+	i := &discordgo.Interaction{
+		Type: discordgo.InteractionApplicationCommandAutocomplete,
+		Data: discordgo.ApplicationCommandInteractionData{
+			Options: []*discordgo.ApplicationCommandInteractionDataOption{
+				{Name: "sub", Type: discordgo.ApplicationCommandOptionSubCommand, Options: []*discordgo.ApplicationCommandInteractionDataOption{
+					{Name: "other", Value: "x"},
+					{Name: "fruit", Value: "app", Focused: true},
+				}},
+			},
+		},
+	}
+	opt, ok := FocusedOption(i)
+	if !ok || opt.Name != "fruit" {
+		t.Errorf("Expected focused option fruit, got %+v", opt)
+	}
+
+	if _, ok := FocusedOption(&discordgo.Interaction{Type: discordgo.InteractionModalSubmit}); ok {
+		t.Error("Expected no focused option for a non-autocomplete interaction")
+	}
+}
@@ -0,0 +1,148 @@
+package disgomsg
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// DefaultAutoDeferThreshold is the time after which an AutoDeferResponse defers the interaction if no response has
+// been sent, leaving a margin before Discord's 3-second deadline.
+const DefaultAutoDeferThreshold = 2500 * time.Millisecond
+
+// AutoDeferResponse is a Response bound to an interaction that is automatically deferred if it has not been sent
+// within a threshold. Once deferred, Send edits the original response instead. An AutoDeferResponse is safe for
+// concurrent use; its methods are serialized, so a method called while a request is in progress, including any
+// retry backoff, waits for that request to finish.
+type AutoDeferResponse struct {
+	mu        sync.Mutex
+	response  *Response
+	s         Sender
+	i         *discordgo.Interaction
+	ephemeral bool
+	options   []discordgo.RequestOption
+	timer     *time.Timer
+	done      bool  // Set once the response has been sent or the timer stopped.
+	deferErr  error // Error returned when deferring the interaction, if any.
+}
+
+// NewAutoDeferResponse creates a response to the interaction and starts a timer that defers the interaction if
+// Send has not been called within the threshold. A threshold of zero or less uses DefaultAutoDeferThreshold. The
+// ephemeral flag determines whether the deferred reply is ephemeral. The request options are used for all
+// requests made, including the automatic deferral.
+func NewAutoDeferResponse(s Sender, i *discordgo.Interaction, threshold time.Duration, ephemeral bool, options ...discordgo.RequestOption) *AutoDeferResponse {
+	if threshold <= 0 {
+		threshold = DefaultAutoDeferThreshold
+	}
+	a := &AutoDeferResponse{
+		response:  NewResponse(WithInteraction(i)),
+		s:         s,
+		i:         i,
+		ephemeral: ephemeral,
+		options:   options,
+	}
+	a.response.SetEphemeral(ephemeral)
+	a.timer = time.AfterFunc(threshold, a.deferResponse)
+	return a
+}
+
+// deferResponse defers the interaction unless a response has already been sent.
+func (a *AutoDeferResponse) deferResponse() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.done || a.response.state != InteractionStateNew {
+		return
+	}
+	a.deferErr = a.response.Defer(a.s, a.i, a.ephemeral, a.options...)
+}
+
+// Send applies the options to the response and sends it. If the interaction has already been deferred, the
+// original response is edited instead of responding to the interaction.
+func (a *AutoDeferResponse) Send(opts ...Option) error {
+	return a.SendContext(context.Background(), opts...)
+}
+
+// SendContext is like Send, but the requests are bound to the context and any pending retries are abandoned once the
+// context is done. The automatic deferral is not bound to the context.
+func (a *AutoDeferResponse) SendContext(ctx context.Context, opts ...Option) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.timer.Stop()
+	a.done = true
+	for _, opt := range opts {
+		opt((*message)(a.response))
+	}
+	return a.response.SendContext(ctx, a.s, a.i, a.options...)
+}
+
+// Edit edits the response after it has been sent, updating the fields changed by the options. The interaction token
+// is only valid for InteractionTokenLifetime, after which ErrInteractionExpired is returned.
+func (a *AutoDeferResponse) Edit(opts ...Option) error {
+	return a.EditContext(context.Background(), opts...)
+}
+
+// EditContext is like Edit, but the requests are bound to the context and any pending retries are abandoned once the
+// context is done.
+func (a *AutoDeferResponse) EditContext(ctx context.Context, opts ...Option) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, opt := range opts {
+		opt((*message)(a.response))
+	}
+	return a.response.EditContext(ctx, a.s, a.options...)
+}
+
+// Delete deletes the response after it has been sent.
+func (a *AutoDeferResponse) Delete() error {
+	return a.DeleteContext(context.Background())
+}
+
+// DeleteContext is like Delete, but the requests are bound to the context and any pending retries are abandoned once
+// the context is done.
+func (a *AutoDeferResponse) DeleteContext(ctx context.Context) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.response.DeleteContext(ctx, a.s, a.options...)
+}
+
+// State returns the lifecycle state of the response.
+func (a *AutoDeferResponse) State() InteractionState {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.response.State()
+}
+
+// ExpiresAt returns when the interaction token expires, after which the response can no longer be edited or deleted.
+// The zero time is returned if the expiry is not yet known.
+func (a *AutoDeferResponse) ExpiresAt() time.Time {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.response.ExpiresAt()
+}
+
+// Stop stops the timer without sending a response, such as when the handler responds to the interaction in
+// another way. It reports whether the interaction had already been deferred.
+func (a *AutoDeferResponse) Stop() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.timer.Stop()
+	a.done = true
+	return !a.response.deferredAt.IsZero()
+}
+
+// Deferred reports whether the interaction has been deferred. It waits for any request in progress to finish.
+func (a *AutoDeferResponse) Deferred() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return !a.response.deferredAt.IsZero()
+}
+
+// DeferErr returns the error from automatically deferring the interaction, if deferring failed. It waits for any
+// request in progress to finish.
+func (a *AutoDeferResponse) DeferErr() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.deferErr
+}
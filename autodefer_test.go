@@ -0,0 +1,148 @@
+package disgomsg
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// waitFor waits until the condition is true, failing the test if it does not become true within a second.
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for condition")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestAutoDeferResponseSendBeforeThreshold(t *testing.T) {
+	rec := NewRecorder()
+	interaction := &discordgo.Interaction{ID: "interaction-1"}
+	a := NewAutoDeferResponse(rec, interaction, time.Hour, false)
+
+	if err := a.Send(WithContent("fast")); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if a.Deferred() {
+		t.Error("Expected response not to be deferred")
+	}
+	requests := rec.Requests()
+	if len(requests) != 1 || requests[0].Method != "InteractionRespond" {
+		t.Fatalf("Expected a single InteractionRespond, got %+v", requests)
+	}
+	if requests[0].InteractionResponse.Data.Content != "fast" {
+		t.Errorf("Expected content %q, got %q", "fast", requests[0].InteractionResponse.Data.Content)
+	}
+}
+
+func TestAutoDeferResponseSendAfterThreshold(t *testing.T) {
+	rec := NewRecorder()
+	interaction := &discordgo.Interaction{ID: "interaction-1"}
+	a := NewAutoDeferResponse(rec, interaction, time.Millisecond, true)
+
+	waitFor(t, a.Deferred)
+	if err := a.Send(WithContent("slow")); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+
+	requests := rec.Requests()
+	if len(requests) != 2 {
+		t.Fatalf("Expected 2 requests, got %d", len(requests))
+	}
+	if requests[0].InteractionResponse.Type != discordgo.InteractionResponseDeferredChannelMessageWithSource {
+		t.Errorf("Expected a deferred response, got %v", requests[0].InteractionResponse.Type)
+	}
+	if requests[0].InteractionResponse.Data.Flags&discordgo.MessageFlagsEphemeral == 0 {
+		t.Error("Expected the deferred response to be ephemeral")
+	}
+	if requests[1].Method != "InteractionResponseEdit" || *requests[1].WebhookEdit.Content != "slow" {
+		t.Errorf("Expected Send to edit the original response, got %+v", requests[1])
+	}
+}
+
+func TestAutoDeferResponseStop(t *testing.T) {
+	rec := NewRecorder()
+	a := NewAutoDeferResponse(rec, &discordgo.Interaction{}, 10*time.Millisecond, false)
+	if a.Stop() {
+		t.Error("Expected interaction not to have been deferred")
+	}
+	time.Sleep(30 * time.Millisecond)
+	if len(rec.Requests()) != 0 {
+		t.Errorf("Expected no requests after Stop, got %d", len(rec.Requests()))
+	}
+}
+
+func TestAutoDeferResponseDeferError(t *testing.T) {
+	deferErr := errors.New("unknown interaction")
+	rec := NewRecorder()
+	rec.Errors = map[string]error{"InteractionRespond": deferErr}
+	a := NewAutoDeferResponse(rec, &discordgo.Interaction{}, time.Millisecond, false)
+
+	waitFor(t, func() bool { return a.DeferErr() != nil })
+	if !errors.Is(a.DeferErr(), deferErr) {
+		t.Errorf("Expected %v, got %v", deferErr, a.DeferErr())
+	}
+	if a.Deferred() {
+		t.Error("Expected response not to be deferred after a failure")
+	}
+}
+
+func TestAutoDeferResponseConcurrentSend(t *testing.T) {
+	for n := 0; n < 20; n++ {
+		rec := NewRecorder()
+		a := NewAutoDeferResponse(rec, &discordgo.Interaction{}, time.Millisecond, false)
+
+		// The timer and Send race, so either ordering is valid but the requests must be consistent.
+		time.Sleep(time.Millisecond)
+		_ = a.Send(WithContent("result"))
+		time.Sleep(2 * time.Millisecond)
+
+		requests := rec.Requests()
+		switch len(requests) {
+		case 1:
+			if requests[0].Method != "InteractionRespond" || requests[0].InteractionResponse.Data.Content != "result" {
+				t.Errorf("Expected an immediate response, got %+v", requests[0])
+			}
+		case 2:
+			if requests[0].Method != "InteractionRespond" || requests[1].Method != "InteractionResponseEdit" {
+				t.Errorf("Expected a deferral followed by an edit, got %q and %q", requests[0].Method, requests[1].Method)
+			}
+		default:
+			t.Errorf("Expected 1 or 2 requests, got %d", len(requests))
+		}
+	}
+}
+
+func TestAutoDeferResponseEditDelete(t *testing.T) {
+	rec := NewRecorder()
+	interaction := &discordgo.Interaction{ID: "interaction-1"}
+	a := NewAutoDeferResponse(rec, interaction, time.Hour, false)
+
+	if err := a.Edit(WithContent("too early")); !errors.Is(err, ErrNotResponded) {
+		t.Errorf("Expected ErrNotResponded before sending, got %v", err)
+	}
+	if err := a.Send(WithContent("working")); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if a.State() != InteractionStateResponded || a.ExpiresAt().IsZero() {
+		t.Errorf("Expected a responded state with a known expiry, got %v and %v", a.State(), a.ExpiresAt())
+	}
+	if err := a.Edit(WithContent("done")); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	req := last(rec)
+	if req.Method != "InteractionResponseEdit" || req.WebhookEdit.Content == nil || *req.WebhookEdit.Content != "done" {
+		t.Errorf("Expected the response to be edited with the new content, got %+v", req)
+	}
+	if err := a.Delete(); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	if a.State() != InteractionStateDeleted {
+		t.Errorf("Expected deleted state, got %v", a.State())
+	}
+}
@@ -1,6 +1,8 @@
 package disgomsg
 
 import (
+	"context"
+
 	"github.com/bwmarrin/discordgo"
 )
 
@@ -13,95 +15,118 @@ func NewMessage(opts ...Option) *Message {
 	return (*Message)(message)
 }
 
-// Send s the message to the specified channel using the provided Discord session.
-func (m *Message) Send(s *discordgo.Session, channelID string, options ...discordgo.RequestOption) (string, error) {
-	message := &discordgo.MessageSend{
-		AllowedMentions: m.allowedMentions,
-		Components:      m.components,
-		Content:         m.content,
-		Embeds:          m.embeds,
-		Files:           m.files,
-		Flags:           m.flags,
-		Reference:       m.reference,
-		StickerIDs:      m.stickerIDs,
-		TTS:             m.tts,
+// Send sends the message to the specified channel using the provided Discord session. If a thread ID has been set
+// with WithThreadID, the message is sent to that thread instead.
+func (m *Message) Send(s Sender, channelID string, options ...discordgo.RequestOption) (string, error) {
+	return m.SendContext(context.Background(), s, channelID, options...)
+}
+
+// SendContext is like Send, but the requests are bound to the context and any pending retries are abandoned once the
+// context is done.
+func (m *Message) SendContext(ctx context.Context, s Sender, channelID string, options ...discordgo.RequestOption) (string, error) {
+	if m.validate {
+		if err := m.Validate(); err != nil {
+			return "", err
+		}
 	}
 	m.channelID = channelID
-	sent, err := s.ChannelMessageSendComplex(m.channelID, message, options...)
+	if m.threadID != "" {
+		m.channelID = m.threadID
+	}
+	return (*message)(m).sendToChannel(ctx, s, options...)
+}
+
+// Edit edits the existing message using the provided Discord session. Only the fields changed since the message was
+// last sent, edited or hydrated are updated, so others are left untouched; use WithFullEdit to update them all.
+// Set fields to empty values, such as with ClearEmbeds, to remove them.
+func (m *Message) Edit(s Sender, options ...discordgo.RequestOption) error {
+	return m.EditContext(context.Background(), s, options...)
+}
+
+// EditContext is like Edit, but the requests are bound to the context and any pending retries are abandoned once the
+// context is done.
+func (m *Message) EditContext(ctx context.Context, s Sender, options ...discordgo.RequestOption) error {
+	return (*message)(m).editInChannel(ctx, s, options...)
+}
+
+// Delete deletes the message using the provided Discord session and clears the MessageID to indicate it has been deleted.
+func (m *Message) Delete(s Sender, options ...discordgo.RequestOption) error {
+	return m.DeleteContext(context.Background(), s, options...)
+}
+
+// DeleteContext is like Delete, but the requests are bound to the context and any pending retries are abandoned once
+// the context is done.
+func (m *Message) DeleteContext(ctx context.Context, s Sender, options ...discordgo.RequestOption) error {
+	return (*message)(m).deleteFromChannel(ctx, s, options...)
+}
+
+// ChannelID returns the ID of the channel or thread the message was sent to.
+func (m *Message) ChannelID() string {
+	return m.channelID
+}
+
+// MessageID returns the ID of the message, or an empty string if it has not been sent or has been deleted.
+func (m *Message) MessageID() string {
+	return m.messageID
+}
+
+// sendToChannel sends the message to its channel, recording the ID of the message that was sent.
+func (m *message) sendToChannel(ctx context.Context, s Sender, options ...discordgo.RequestOption) (string, error) {
+	data := m.toMessageSend()
+	var sent *discordgo.Message
+	err := m.do(ctx, "send message", options, func(options ...discordgo.RequestOption) (err error) {
+		sent, err = s.ChannelMessageSendComplex(m.channelID, data, options...)
+		return err
+	})
 	if err != nil {
 		return "", err
 	}
 	m.messageID = sent.ID
+	m.markSent()
 
 	return sent.ID, nil
 }
 
-// Edit edits the existing message using the provided Discord session and updates its content, components, embeds, and flags.
-func (m *Message) Edit(s *discordgo.Session, options ...discordgo.RequestOption) error {
+// editInChannel edits the message in its channel, sending the fields that have changed.
+func (m *message) editInChannel(ctx context.Context, s Sender, options ...discordgo.RequestOption) error {
 	if m.channelID == "" {
 		return ErrMissingChannelID
 	}
 	if m.messageID == "" {
 		return ErrMissingMessageID
 	}
-	message := &discordgo.MessageEdit{
-		ID:         m.messageID,
-		Channel:    m.channelID,
-		Content:    &m.content,
-		Components: &m.components,
-		Embeds:     &m.embeds,
-		Flags:      m.flags,
+	if m.validate {
+		if err := m.validateEdit(); err != nil {
+			return err
+		}
 	}
-	_, err := s.ChannelMessageEditComplex(message, options...)
+	data := m.toMessageEdit()
+	err := m.do(ctx, "edit message", options, func(options ...discordgo.RequestOption) error {
+		_, err := s.ChannelMessageEditComplex(data, options...)
+		return err
+	})
 	if err != nil {
 		return err
 	}
+	m.markSent()
 
 	return nil
 }
 
-// Delete deletes the message using the provided Discord session and clears the MessageID to indicate it has been deleted.
-func (m *Message) Delete(s *discordgo.Session, options ...discordgo.RequestOption) error {
+// deleteFromChannel deletes the message from its channel and clears the message ID.
+func (m *message) deleteFromChannel(ctx context.Context, s Sender, options ...discordgo.RequestOption) error {
 	if m.channelID == "" {
 		return ErrMissingChannelID
 	}
 	if m.messageID == "" {
 		return ErrMissingMessageID
 	}
-	err := s.ChannelMessageDelete(m.channelID, m.messageID, options...)
+	err := m.do(ctx, "delete message", options, func(options ...discordgo.RequestOption) error {
+		return s.ChannelMessageDelete(m.channelID, m.messageID, options...)
+	})
 	if err != nil {
 		return err
 	}
 	m.messageID = "" // Clear the ID after deletion
 	return nil
 }
-
-// WithChannelID sets the channel ID for the message.
-func (m *Message) WithChannelID(channelID string) *Message {
-	m.channelID = channelID
-	return m
-}
-
-// WithMessageID sets the message ID for the message.
-func (m *Message) WithMessageID(messageID string) *Message {
-	m.messageID = messageID
-	return m
-}
-
-// WithContent sets the content for the message.
-func (m *Message) WithContent(content string) *Message {
-	m.content = content
-	return m
-}
-
-// WithEmbeds sets the embeds for the message.
-func (m *Message) WithEmbeds(embeds []*discordgo.MessageEmbed) *Message {
-	m.embeds = embeds
-	return m
-}
-
-// WithComponents sets the components for the message.
-func (m *Message) WithComponents(components []discordgo.MessageComponent) *Message {
-	m.components = components
-	return m
-}
@@ -1,6 +1,7 @@
 package disgomsg
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/bwmarrin/discordgo"
@@ -96,7 +97,189 @@ func TestMessageWithMethods(t *testing.T) {
 	}
 }
 
-// Note: We can't easily test Send, Edit, and Delete methods
-// without mocking the discordgo.Session interface, which is quite large.
-// In a real-world scenario, you might use a mocking library or create a test
-// wrapper around the discordgo.Session interface.
+func TestMessageSendEditDelete(t *testing.T) {
+	rec := NewRecorder()
+	msg := NewMessage(WithContent("hello"))
+
+	messageID, err := msg.Send(rec, "channel-1")
+	if err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if messageID == "" || msg.messageID != messageID {
+		t.Errorf("Expected messageID to be recorded, got %q", msg.messageID)
+	}
+	if msg.channelID != "channel-1" {
+		t.Errorf("Expected channelID %q, got %q", "channel-1", msg.channelID)
+	}
+	req, _ := rec.Last()
+	if req.Method != "ChannelMessageSendComplex" || req.ChannelID != "channel-1" || req.MessageSend.Content != "hello" {
+		t.Errorf("Unexpected send request %+v", req)
+	}
+
+	if err := msg.WithContent("updated").Edit(rec); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	req, _ = rec.Last()
+	if req.Method != "ChannelMessageEditComplex" || req.MessageEdit.ID != messageID || *req.MessageEdit.Content != "updated" {
+		t.Errorf("Unexpected edit request %+v", req)
+	}
+
+	if err := msg.Delete(rec); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	req, _ = rec.Last()
+	if req.Method != "ChannelMessageDelete" || req.MessageID != messageID {
+		t.Errorf("Unexpected delete request %+v", req)
+	}
+	if msg.messageID != "" {
+		t.Errorf("Expected messageID to be cleared, got %q", msg.messageID)
+	}
+
+	if err := msg.Edit(rec); !errors.Is(err, ErrMissingMessageID) {
+		t.Errorf("Expected ErrMissingMessageID, got %v", err)
+	}
+	if err := NewMessage().Delete(rec); !errors.Is(err, ErrMissingChannelID) {
+		t.Errorf("Expected ErrMissingChannelID, got %v", err)
+	}
+}
+
+func TestMessageSendError(t *testing.T) {
+	sendErr := errors.New("send failed")
+	rec := NewRecorder()
+	rec.Errors = map[string]error{"ChannelMessageSendComplex": sendErr}
+
+	msg := NewMessage(WithContent("hello"))
+	if _, err := msg.Send(rec, "channel-1"); !errors.Is(err, sendErr) {
+		t.Errorf("Expected %v, got %v", sendErr, err)
+	}
+	if msg.messageID != "" {
+		t.Errorf("Expected no messageID after failed send, got %q", msg.messageID)
+	}
+}
+
+func TestMessageEditFields(t *testing.T) {
+	rec := NewRecorder()
+	mentions := &discordgo.MessageAllowedMentions{Parse: []discordgo.AllowedMentionType{}}
+	files := []*discordgo.File{{Name: "report.txt"}}
+	retained := []*discordgo.MessageAttachment{{ID: "attachment-1"}}
+	msg := NewMessage(
+		WithChannelID("channel-1"),
+		WithMessageID("message-1"),
+		WithAllowedMentions(mentions),
+		WithFiles(files),
+		WithAttachments(retained),
+	)
+
+	if err := msg.Edit(rec); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	req, _ := rec.Last()
+	edit := req.MessageEdit
+	if edit.AllowedMentions != mentions {
+		t.Errorf("Expected allowed mentions to be sent, got %+v", edit.AllowedMentions)
+	}
+	if len(edit.Files) != 1 {
+		t.Errorf("Expected 1 file to be uploaded, got %d", len(edit.Files))
+	}
+	if edit.Attachments == nil || len(*edit.Attachments) != 1 || (*edit.Attachments)[0].ID != "attachment-1" {
+		t.Errorf("Expected the retained attachment to be sent, got %v", edit.Attachments)
+	}
+	if edit.Content != nil || edit.Embeds != nil || edit.Components != nil {
+		t.Errorf("Expected fields that were not set to be left untouched, got %+v", edit)
+	}
+
+	// Files are only uploaded once.
+	if err := msg.WithContent("updated").Edit(rec); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	req, _ = rec.Last()
+	if len(req.MessageEdit.Files) != 0 {
+		t.Errorf("Expected files not to be uploaded again, got %d", len(req.MessageEdit.Files))
+	}
+}
+
+func TestMessageEditClear(t *testing.T) {
+	rec := NewRecorder()
+	msg := NewMessage(
+		WithChannelID("channel-1"),
+		WithMessageID("message-1"),
+		ClearContent(),
+		ClearEmbeds(),
+		ClearComponents(),
+		ClearAttachments(),
+	)
+	if err := msg.Edit(rec); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	req, _ := rec.Last()
+	edit := req.MessageEdit
+	if edit.Content == nil || *edit.Content != "" {
+		t.Errorf("Expected content to be cleared, got %v", edit.Content)
+	}
+	if edit.Embeds == nil || len(*edit.Embeds) != 0 {
+		t.Errorf("Expected embeds to be cleared, got %v", edit.Embeds)
+	}
+	if edit.Components == nil || len(*edit.Components) != 0 {
+		t.Errorf("Expected components to be cleared, got %v", edit.Components)
+	}
+	if edit.Attachments == nil || len(*edit.Attachments) != 0 {
+		t.Errorf("Expected attachments to be cleared, got %v", edit.Attachments)
+	}
+}
+
+func TestMessageEditChangedFields(t *testing.T) {
+	rec := NewRecorder()
+	msg := NewMessage(
+		WithContent("hello"),
+		WithEmbeds([]*discordgo.MessageEmbed{{Title: "Report"}}),
+		WithFlags(discordgo.MessageFlagsSuppressEmbeds),
+	)
+	if _, err := msg.Send(rec, "channel-1"); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+
+	// Only the content changed since the message was sent.
+	editErr := errors.New("edit failed")
+	rec.Errors = map[string]error{"ChannelMessageEditComplex": editErr}
+	if err := msg.WithContent("updated").Edit(rec); !errors.Is(err, editErr) {
+		t.Fatalf("Expected %v, got %v", editErr, err)
+	}
+	rec.Errors = nil
+	if err := msg.Edit(rec); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	req, _ := rec.Last()
+	edit := req.MessageEdit
+	if edit.Content == nil || *edit.Content != "updated" {
+		t.Errorf("Expected changed content to be retried after a failed edit, got %v", edit.Content)
+	}
+	if edit.Embeds != nil || edit.Components != nil || edit.Flags != 0 {
+		t.Errorf("Expected unchanged fields to be omitted, got %+v", edit)
+	}
+
+	// Nothing changed since the last edit.
+	if err := msg.Edit(rec); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	req, _ = rec.Last()
+	if req.MessageEdit.Content != nil {
+		t.Errorf("Expected content to be omitted once edited, got %q", *req.MessageEdit.Content)
+	}
+
+	// A full edit includes everything but files and attachments.
+	WithFullEdit(true)((*message)(msg))
+	if err := msg.Edit(rec); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	req, _ = rec.Last()
+	edit = req.MessageEdit
+	if edit.Content == nil || edit.Embeds == nil || len(*edit.Embeds) != 1 || edit.Components == nil {
+		t.Errorf("Expected a full edit to include all fields, got %+v", edit)
+	}
+	if edit.Flags != discordgo.MessageFlagsSuppressEmbeds {
+		t.Errorf("Expected a full edit to include the flags, got %v", edit.Flags)
+	}
+	if edit.Attachments != nil || edit.Files != nil {
+		t.Errorf("Expected a full edit to omit unchanged attachments and files, got %+v", edit)
+	}
+}
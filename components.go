@@ -0,0 +1,162 @@
+package disgomsg
+
+import (
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// ButtonBuilder is a fluent builder for Discord buttons.
+type ButtonBuilder struct {
+	button discordgo.Button
+}
+
+// NewButton creates a new button builder for a button with the given style, label and custom ID.
+func NewButton(style discordgo.ButtonStyle, label, customID string) *ButtonBuilder {
+	return &ButtonBuilder{button: discordgo.Button{Style: style, Label: label, CustomID: customID}}
+}
+
+// NewLinkButton creates a new button builder for a button that opens the given URL.
+func NewLinkButton(label, url string) *ButtonBuilder {
+	return &ButtonBuilder{button: discordgo.Button{Style: discordgo.LinkButton, Label: label, URL: url}}
+}
+
+// WithEmoji sets the emoji for the button.
+func (b *ButtonBuilder) WithEmoji(emoji *discordgo.ComponentEmoji) *ButtonBuilder {
+	b.button.Emoji = emoji
+	return b
+}
+
+// WithDisabled sets whether the button is disabled.
+func (b *ButtonBuilder) WithDisabled(disabled bool) *ButtonBuilder {
+	b.button.Disabled = disabled
+	return b
+}
+
+// Build creates the button.
+func (b *ButtonBuilder) Build() discordgo.Button {
+	return b.button
+}
+
+// SelectMenuBuilder is a fluent builder for Discord select menus.
+type SelectMenuBuilder struct {
+	menu discordgo.SelectMenu
+}
+
+// NewStringSelect creates a new select menu builder for a menu whose options are provided with AddOption.
+func NewStringSelect(customID string) *SelectMenuBuilder {
+	return newSelectMenu(discordgo.StringSelectMenu, customID)
+}
+
+// NewUserSelect creates a new select menu builder for a menu populated with users.
+func NewUserSelect(customID string) *SelectMenuBuilder {
+	return newSelectMenu(discordgo.UserSelectMenu, customID)
+}
+
+// NewRoleSelect creates a new select menu builder for a menu populated with roles.
+func NewRoleSelect(customID string) *SelectMenuBuilder {
+	return newSelectMenu(discordgo.RoleSelectMenu, customID)
+}
+
+// NewChannelSelect creates a new select menu builder for a menu populated with channels.
+func NewChannelSelect(customID string) *SelectMenuBuilder {
+	return newSelectMenu(discordgo.ChannelSelectMenu, customID)
+}
+
+// NewMentionableSelect creates a new select menu builder for a menu populated with users and roles.
+func NewMentionableSelect(customID string) *SelectMenuBuilder {
+	return newSelectMenu(discordgo.MentionableSelectMenu, customID)
+}
+
+// newSelectMenu creates a new select menu builder of the given type.
+func newSelectMenu(menuType discordgo.SelectMenuType, customID string) *SelectMenuBuilder {
+	return &SelectMenuBuilder{menu: discordgo.SelectMenu{MenuType: menuType, CustomID: customID}}
+}
+
+// WithPlaceholder sets the text shown when nothing is selected.
+func (b *SelectMenuBuilder) WithPlaceholder(placeholder string) *SelectMenuBuilder {
+	b.menu.Placeholder = placeholder
+	return b
+}
+
+// WithMinValues sets the minimum number of items that must be selected.
+func (b *SelectMenuBuilder) WithMinValues(minValues int) *SelectMenuBuilder {
+	b.menu.MinValues = &minValues
+	return b
+}
+
+// WithMaxValues sets the maximum number of items that may be selected.
+func (b *SelectMenuBuilder) WithMaxValues(maxValues int) *SelectMenuBuilder {
+	b.menu.MaxValues = maxValues
+	return b
+}
+
+// WithDisabled sets whether the select menu is disabled.
+func (b *SelectMenuBuilder) WithDisabled(disabled bool) *SelectMenuBuilder {
+	b.menu.Disabled = disabled
+	return b
+}
+
+// WithChannelTypes restricts the channels shown in a channel select menu to the given types.
+func (b *SelectMenuBuilder) WithChannelTypes(channelTypes ...discordgo.ChannelType) *SelectMenuBuilder {
+	b.menu.ChannelTypes = channelTypes
+	return b
+}
+
+// WithDefaultValues sets the users, roles or channels that are selected by default in an auto-populated select menu.
+func (b *SelectMenuBuilder) WithDefaultValues(defaultValues ...discordgo.SelectMenuDefaultValue) *SelectMenuBuilder {
+	b.menu.DefaultValues = defaultValues
+	return b
+}
+
+// AddOption adds an option to a string select menu. The description is optional.
+func (b *SelectMenuBuilder) AddOption(label, value, description string) *SelectMenuBuilder {
+	b.menu.Options = append(b.menu.Options, discordgo.SelectMenuOption{Label: label, Value: value, Description: description})
+	return b
+}
+
+// AddDefaultOption adds an option to a string select menu that is selected by default. The description is optional.
+func (b *SelectMenuBuilder) AddDefaultOption(label, value, description string) *SelectMenuBuilder {
+	b.menu.Options = append(b.menu.Options, discordgo.SelectMenuOption{Label: label, Value: value, Description: description, Default: true})
+	return b
+}
+
+// Build creates the select menu.
+func (b *SelectMenuBuilder) Build() discordgo.SelectMenu {
+	return b.menu
+}
+
+// LayoutComponents packs the buttons and select menus into action rows, in order. Consecutive buttons share a
+// row, up to the per-row limit, while each select menu is placed in a row of its own. An error is returned if the
+// components do not fit within the maximum number of rows, or if a component cannot be placed in an action row.
+func LayoutComponents(components ...discordgo.MessageComponent) ([]discordgo.MessageComponent, error) {
+	var rows []discordgo.MessageComponent
+	var buttons []discordgo.MessageComponent
+	flush := func() {
+		if len(buttons) > 0 {
+			rows = append(rows, discordgo.ActionsRow{Components: buttons})
+			buttons = nil
+		}
+	}
+
+	for i, component := range components {
+		switch component.(type) {
+		case discordgo.Button, *discordgo.Button:
+			if len(buttons) == MaxActionRowComponents {
+				flush()
+			}
+			buttons = append(buttons, component)
+		case discordgo.SelectMenu, *discordgo.SelectMenu:
+			flush()
+			rows = append(rows, discordgo.ActionsRow{Components: []discordgo.MessageComponent{component}})
+		default:
+			return nil, fmt.Errorf("%w: component %d of type %T cannot be placed in an action row", ErrLayout, i, component)
+		}
+	}
+	flush()
+
+	if len(rows) > MaxActionRows {
+		return nil, fmt.Errorf("%w: %d action rows needed, maximum is %d", ErrLayout, len(rows), MaxActionRows)
+	}
+	return rows, nil
+}
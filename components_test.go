@@ -0,0 +1,102 @@
+package disgomsg
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestButtonBuilder(t *testing.T) {
+	emoji := &discordgo.ComponentEmoji{Name: "👍"}
+	button := NewButton(discordgo.SuccessButton, "Approve", "approve").WithEmoji(emoji).WithDisabled(true).Build()
+	if button.Style != discordgo.SuccessButton || button.Label != "Approve" || button.CustomID != "approve" {
+		t.Errorf("Unexpected button %+v", button)
+	}
+	if button.Emoji != emoji || !button.Disabled {
+		t.Errorf("Expected emoji and disabled to be set, got %+v", button)
+	}
+
+	link := NewLinkButton("Docs", "https://example.com").Build()
+	if link.Style != discordgo.LinkButton || link.URL != "https://example.com" || link.CustomID != "" {
+		t.Errorf("Unexpected link button %+v", link)
+	}
+}
+
+func TestSelectMenuBuilder(t *testing.T) {
+	menu := NewStringSelect("color").
+		WithPlaceholder("Pick a color").
+		WithMinValues(1).
+		WithMaxValues(2).
+		AddOption("Red", "red", "").
+		AddDefaultOption("Blue", "blue", "The default").
+		Build()
+	if menu.MenuType != discordgo.StringSelectMenu || menu.CustomID != "color" || menu.Placeholder != "Pick a color" {
+		t.Errorf("Unexpected select menu %+v", menu)
+	}
+	if menu.MinValues == nil || *menu.MinValues != 1 || menu.MaxValues != 2 {
+		t.Errorf("Unexpected min/max values %v/%d", menu.MinValues, menu.MaxValues)
+	}
+	if len(menu.Options) != 2 || menu.Options[0].Default || !menu.Options[1].Default {
+		t.Errorf("Unexpected options %+v", menu.Options)
+	}
+
+	tests := []struct {
+		builder  *SelectMenuBuilder
+		menuType discordgo.SelectMenuType
+	}{
+		{NewUserSelect("user"), discordgo.UserSelectMenu},
+		{NewRoleSelect("role"), discordgo.RoleSelectMenu},
+		{NewChannelSelect("channel").WithChannelTypes(discordgo.ChannelTypeGuildText), discordgo.ChannelSelectMenu},
+		{NewMentionableSelect("mentionable"), discordgo.MentionableSelectMenu},
+	}
+	for _, tt := range tests {
+		if menu := tt.builder.Build(); menu.MenuType != tt.menuType {
+			t.Errorf("Expected menu type %v, got %v", tt.menuType, menu.MenuType)
+		}
+	}
+}
+
+func TestLayoutComponents(t *testing.T) {
+	var components []discordgo.MessageComponent
+	for i := 0; i < 7; i++ {
+		components = append(components, NewButton(discordgo.PrimaryButton, "b", "b").Build())
+	}
+	components = append(components, NewStringSelect("menu").AddOption("a", "a", "").Build())
+	components = append(components, NewLinkButton("Docs", "https://example.com").Build())
+
+	rows, err := LayoutComponents(components...)
+	if err != nil {
+		t.Fatalf("LayoutComponents returned error: %v", err)
+	}
+	expected := []int{5, 2, 1, 1}
+	if len(rows) != len(expected) {
+		t.Fatalf("Expected %d rows, got %d", len(expected), len(rows))
+	}
+	for i, n := range expected {
+		row := rows[i].(discordgo.ActionsRow)
+		if len(row.Components) != n {
+			t.Errorf("Expected row %d to have %d components, got %d", i, n, len(row.Components))
+		}
+	}
+	if _, ok := rows[2].(discordgo.ActionsRow).Components[0].(discordgo.SelectMenu); !ok {
+		t.Error("Expected the select menu to be in its own row")
+	}
+	if err := NewMessage(WithContent("x"), WithComponents(rows)).Validate(); err != nil {
+		t.Errorf("Expected layout to be valid, got %v", err)
+	}
+}
+
+func TestLayoutComponentsOverflow(t *testing.T) {
+	var components []discordgo.MessageComponent
+	for i := 0; i < MaxActionRows*MaxActionRowComponents+1; i++ {
+		components = append(components, NewButton(discordgo.PrimaryButton, "b", "b").Build())
+	}
+	if _, err := LayoutComponents(components...); !errors.Is(err, ErrLayout) {
+		t.Errorf("Expected ErrLayout, got %v", err)
+	}
+
+	if _, err := LayoutComponents(discordgo.TextInput{CustomID: "input"}); !errors.Is(err, ErrLayout) {
+		t.Errorf("Expected ErrLayout for a text input, got %v", err)
+	}
+}
@@ -0,0 +1,185 @@
+package disgomsg
+
+import (
+	"github.com/bwmarrin/discordgo"
+)
+
+// ToMessageSend returns the payload used to send the message to a channel.
+func (m *Message) ToMessageSend() *discordgo.MessageSend {
+	return (*message)(m).toMessageSend()
+}
+
+// ToMessageEdit returns the payload used to edit the message, containing the fields that have changed since it was
+// last sent or edited.
+func (m *Message) ToMessageEdit() *discordgo.MessageEdit {
+	return (*message)(m).toMessageEdit()
+}
+
+// ToMessageSend returns the payload used to send the direct message to the member's private channel.
+func (dm *DirectMessage) ToMessageSend() *discordgo.MessageSend {
+	return (*message)(dm).toMessageSend()
+}
+
+// ToMessageEdit returns the payload used to edit the direct message, containing the fields that have changed since
+// it was last sent or edited.
+func (dm *DirectMessage) ToMessageEdit() *discordgo.MessageEdit {
+	return (*message)(dm).toMessageEdit()
+}
+
+// ToInteractionResponse returns the payload used to respond to the interaction. The response type defaults to
+// InteractionResponseChannelMessageWithSource if none has been set.
+func (r *Response) ToInteractionResponse() *discordgo.InteractionResponse {
+	return (*message)(r).toInteractionResponse()
+}
+
+// ToWebhookEdit returns the payload used to edit the original response to the interaction, or to send the
+// response once the interaction has been deferred.
+func (r *Response) ToWebhookEdit() *discordgo.WebhookEdit {
+	return (*message)(r).toWebhookEdit()
+}
+
+// ToWebhookParams returns the payload used to send the follow-up message.
+func (f *Followup) ToWebhookParams() *discordgo.WebhookParams {
+	return (*message)(f).toWebhookParams()
+}
+
+// ToWebhookEdit returns the payload used to edit the follow-up message.
+func (f *Followup) ToWebhookEdit() *discordgo.WebhookEdit {
+	return (*message)(f).toWebhookEdit()
+}
+
+// ToWebhookParams returns the payload used to send the message through a webhook, including any username, avatar
+// and thread name overrides.
+func (w *WebhookMessage) ToWebhookParams() *discordgo.WebhookParams {
+	params := (*message)(w).toWebhookParams()
+	params.Username = w.username
+	params.AvatarURL = w.avatarURL
+	params.ThreadName = w.threadName
+	return params
+}
+
+// ToWebhookEdit returns the payload used to edit the message sent through a webhook.
+func (w *WebhookMessage) ToWebhookEdit() *discordgo.WebhookEdit {
+	return (*message)(w).toWebhookEdit()
+}
+
+// toMessageSend converts the message to the payload for creating a channel message.
+func (m *message) toMessageSend() *discordgo.MessageSend {
+	return &discordgo.MessageSend{
+		AllowedMentions: m.allowedMentions,
+		Components:      m.components,
+		Content:         m.content,
+		Embeds:          m.embeds,
+		Files:           m.files,
+		Flags:           m.flags,
+		Reference:       m.reference,
+		StickerIDs:      m.stickerIDs,
+		TTS:             m.tts,
+	}
+}
+
+// toMessageEdit converts the message to the payload for editing a channel message. Only the fields that have
+// changed since the message was last sent, edited or hydrated are included, unless a full edit was requested. The
+// payload refers to copies of the message's fields, so changing it does not change the message.
+func (m *message) toMessageEdit() *discordgo.MessageEdit {
+	include := m.editMask(false)
+	data := &discordgo.MessageEdit{
+		ID:              m.messageID,
+		Channel:         m.channelID,
+		AllowedMentions: m.allowedMentions,
+	}
+	if include&fieldFlags != 0 {
+		// Flags that may only be set when sending, such as suppress notifications, cannot be edited.
+		data.Flags = m.flags & channelEditFlags
+	}
+	data.Content, data.Embeds, data.Components, data.Attachments, data.Files = m.editFields(include)
+	return data
+}
+
+// toInteractionResponse converts the message to the payload for responding to an interaction.
+func (m *message) toInteractionResponse() *discordgo.InteractionResponse {
+	responseType := discordgo.InteractionResponseChannelMessageWithSource
+	if m.responseType != nil {
+		responseType = *m.responseType
+	}
+	attachments := m.attachments
+	return &discordgo.InteractionResponse{
+		Type: responseType,
+		Data: &discordgo.InteractionResponseData{
+			AllowedMentions: m.allowedMentions,
+			Attachments:     &attachments,
+			Components:      m.components,
+			Content:         m.content,
+			Embeds:          m.embeds,
+			Files:           m.files,
+			Flags:           m.flags,
+			Choices:         m.choices,
+			CustomID:        m.customID,
+			Title:           m.title,
+		},
+	}
+}
+
+// toWebhookEdit converts the message to the payload for editing an interaction response or follow-up message.
+// Only the fields that have changed since the message was last sent or edited are included, unless a full edit was
+// requested or a deferred response is being sent for the first time. The payload refers to copies of the message's
+// fields, so changing it does not change the message.
+func (m *message) toWebhookEdit() *discordgo.WebhookEdit {
+	data := &discordgo.WebhookEdit{
+		AllowedMentions: m.allowedMentions,
+	}
+	data.Content, data.Embeds, data.Components, data.Attachments, data.Files = m.editFields(m.editMask(m.state == InteractionStateDeferred))
+	return data
+}
+
+// editMask returns the fields to include in an edit. When initial is set, the edit sends the message for the first
+// time, so every field is included.
+func (m *message) editMask(initial bool) fieldMask {
+	switch {
+	case initial:
+		return allFields
+	case m.fullEdit:
+		return m.changed | fullEditFields
+	default:
+		return m.changed
+	}
+}
+
+// editFields returns copies of the fields in the mask, for inclusion in an edit. Fields not in the mask are
+// returned as nil, so they are omitted from the request.
+func (m *message) editFields(include fieldMask) (content *string, embeds *[]*discordgo.MessageEmbed, components *[]discordgo.MessageComponent, attachments *[]*discordgo.MessageAttachment, files []*discordgo.File) {
+	if include&fieldContent != 0 {
+		c := m.content
+		content = &c
+	}
+	if include&fieldEmbeds != 0 {
+		e := m.embeds
+		embeds = &e
+	}
+	if include&fieldComponents != 0 {
+		c := m.components
+		components = &c
+	}
+	if include&fieldAttachments != 0 {
+		a := m.attachments
+		attachments = &a
+	}
+	if include&fieldFiles != 0 {
+		files = m.files
+	}
+	return content, embeds, components, attachments, files
+}
+
+// toWebhookParams converts the message to the payload for sending a follow-up message.
+func (m *message) toWebhookParams() *discordgo.WebhookParams {
+	return &discordgo.WebhookParams{
+		AllowedMentions: m.allowedMentions,
+		Attachments:     m.attachments,
+		Components:      m.components,
+		Content:         m.content,
+		Embeds:          m.embeds,
+		Files:           m.files,
+		Flags:           m.flags,
+		TTS:             m.tts,
+	}
+}
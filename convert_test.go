@@ -0,0 +1,157 @@
+package disgomsg
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// convertOptions returns options setting every field used by the conversions.
+func convertOptions() []Option {
+	return []Option{
+		WithAllowedMentions(&discordgo.MessageAllowedMentions{Parse: []discordgo.AllowedMentionType{discordgo.AllowedMentionTypeUsers}}),
+		WithAttachments([]*discordgo.MessageAttachment{{ID: "attachment-1"}}),
+		WithChannelID("channel-1"),
+		WithComponents([]discordgo.MessageComponent{discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			NewButton(discordgo.PrimaryButton, "OK", "ok").Build(),
+		}}}),
+		WithContent("hello"),
+		WithEmbeds([]*discordgo.MessageEmbed{{Title: "Title"}}),
+		WithFiles([]*discordgo.File{{Name: "report.txt"}}),
+		WithFlags(discordgo.MessageFlagsSuppressEmbeds),
+		WithMessageID("message-1"),
+		WithReference(&discordgo.MessageReference{MessageID: "message-0"}),
+		WithStickerIDs([]string{"sticker-1"}),
+		WithTTS(true),
+	}
+}
+
+func TestToMessageSend(t *testing.T) {
+	m := NewMessage(convertOptions()...)
+	got := m.ToMessageSend()
+	want := &discordgo.MessageSend{
+		AllowedMentions: m.allowedMentions,
+		Components:      m.components,
+		Content:         "hello",
+		Embeds:          m.embeds,
+		Files:           m.files,
+		Flags:           discordgo.MessageFlagsSuppressEmbeds,
+		Reference:       m.reference,
+		StickerIDs:      []string{"sticker-1"},
+		TTS:             true,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %+v, got %+v", want, got)
+	}
+
+	dm := NewDirectMessage(convertOptions()...)
+	if !reflect.DeepEqual(dm.ToMessageSend(), got) {
+		t.Errorf("Expected direct message payload to match channel message payload")
+	}
+}
+
+func TestToMessageEdit(t *testing.T) {
+	m := NewMessage(convertOptions()...)
+	got := m.ToMessageEdit()
+	if got.ID != "message-1" || got.Channel != "channel-1" {
+		t.Errorf("Expected message-1 in channel-1, got %q in %q", got.ID, got.Channel)
+	}
+	if got.Content == nil || *got.Content != "hello" {
+		t.Errorf("Expected content hello, got %v", got.Content)
+	}
+	if got.Embeds == nil || len(*got.Embeds) != 1 || got.Components == nil || len(*got.Components) != 1 {
+		t.Errorf("Expected 1 embed and 1 component, got %+v", got)
+	}
+
+	// Changing the payload must not change the message.
+	*got.Content = "changed"
+	if m.content != "hello" {
+		t.Errorf("Expected message content to be unchanged, got %q", m.content)
+	}
+
+	if !reflect.DeepEqual(NewDirectMessage(convertOptions()...).ToMessageEdit(), m.ToMessageEdit()) {
+		t.Errorf("Expected direct message payload to match channel message payload")
+	}
+}
+
+func TestToInteractionResponse(t *testing.T) {
+	r := NewResponse(convertOptions()...)
+	got := r.ToInteractionResponse()
+	if got.Type != discordgo.InteractionResponseChannelMessageWithSource {
+		t.Errorf("Expected default response type, got %v", got.Type)
+	}
+	if got.Data.Content != "hello" || len(got.Data.Embeds) != 1 || len(got.Data.Files) != 1 {
+		t.Errorf("Unexpected response data %+v", got.Data)
+	}
+	if got.Data.Attachments == nil || len(*got.Data.Attachments) != 1 {
+		t.Errorf("Expected 1 attachment, got %v", got.Data.Attachments)
+	}
+
+	responseType := discordgo.InteractionResponseUpdateMessage
+	r = NewResponse(WithResponseType(&responseType))
+	if got := r.ToInteractionResponse(); got.Type != responseType {
+		t.Errorf("Expected response type %v, got %v", responseType, got.Type)
+	}
+}
+
+func TestToWebhookEdit(t *testing.T) {
+	r := NewResponse(convertOptions()...)
+	f := NewFollowup(convertOptions()...)
+	got := r.ToWebhookEdit()
+	if !reflect.DeepEqual(f.ToWebhookEdit(), got) {
+		t.Errorf("Expected follow-up payload to match response payload")
+	}
+	if got.Content == nil || *got.Content != "hello" || len(got.Files) != 1 || got.AllowedMentions == nil {
+		t.Errorf("Unexpected webhook edit %+v", got)
+	}
+	if got.Attachments == nil || len(*got.Attachments) != 1 {
+		t.Errorf("Expected 1 attachment, got %v", got.Attachments)
+	}
+}
+
+func TestToWebhookParams(t *testing.T) {
+	f := NewFollowup(convertOptions()...)
+	got := f.ToWebhookParams()
+	if got.Content != "hello" || !got.TTS || len(got.Attachments) != 1 || len(got.Files) != 1 {
+		t.Errorf("Unexpected webhook params %+v", got)
+	}
+}
+
+// last returns the most recent request recorded by the recorder.
+func last(rec *Recorder) RecordedRequest {
+	req, _ := rec.Last()
+	return req
+}
+
+func TestConversionsMatchRequests(t *testing.T) {
+	rec := NewRecorder()
+
+	m := NewMessage(convertOptions()...)
+	if _, err := m.Send(rec, "channel-1"); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if got := last(rec).MessageSend; !reflect.DeepEqual(got, m.ToMessageSend()) {
+		t.Errorf("Expected sent payload %+v, got %+v", m.ToMessageSend(), got)
+	}
+	if err := m.Edit(rec); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	if got := last(rec).MessageEdit; !reflect.DeepEqual(got, m.ToMessageEdit()) {
+		t.Errorf("Expected edited payload %+v, got %+v", m.ToMessageEdit(), got)
+	}
+
+	r := NewResponse(convertOptions()...)
+	if err := r.Send(rec, &discordgo.Interaction{ID: "interaction-1"}); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if got := last(rec).InteractionResponse; !reflect.DeepEqual(got, r.ToInteractionResponse()) {
+		t.Errorf("Expected response payload %+v, got %+v", r.ToInteractionResponse(), got)
+	}
+	if err := r.Edit(rec); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	if got := last(rec).WebhookEdit; !reflect.DeepEqual(got, r.ToWebhookEdit()) {
+		t.Errorf("Expected edit payload %+v, got %+v", r.ToWebhookEdit(), got)
+	}
+}
@@ -1,6 +1,8 @@
 package disgomsg
 
 import (
+	"context"
+
 	"github.com/bwmarrin/discordgo"
 )
 
@@ -15,104 +17,73 @@ func NewDirectMessage(opts ...Option) *DirectMessage {
 }
 
 // Send sends a direct message to the specified member using the provided Discord session.
-func (dm *DirectMessage) Send(s *discordgo.Session, memberID string, options ...discordgo.RequestOption) (messagID string, err error) {
-	channel, err := s.UserChannelCreate(memberID)
-	if err != nil {
-		return "", err
-	}
+func (dm *DirectMessage) Send(s Sender, memberID string, options ...discordgo.RequestOption) (string, error) {
+	return dm.SendContext(context.Background(), s, memberID, options...)
+}
 
-	message := &discordgo.MessageSend{
-		AllowedMentions: dm.allowedMentions,
-		Components:      dm.components,
-		Content:         dm.content,
-		Embeds:          dm.embeds,
-		Files:           dm.files,
-		Flags:           dm.flags,
-		Reference:       dm.reference,
-		StickerIDs:      dm.stickerIDs,
-		TTS:             dm.tts,
+// SendContext is like Send, but the requests are bound to the context and any pending retries are abandoned once the
+// context is done.
+func (dm *DirectMessage) SendContext(ctx context.Context, s Sender, memberID string, options ...discordgo.RequestOption) (string, error) {
+	if dm.validate {
+		if err := dm.Validate(); err != nil {
+			return "", err
+		}
 	}
-
-	sent, err := s.ChannelMessageSendComplex(channel.ID, message, options...)
-	if err != nil {
+	if err := dm.openChannel(ctx, s, memberID, options...); err != nil {
 		return "", err
 	}
-	dm.messageID = sent.ID
-	dm.channelID = channel.ID
-
-	return dm.messageID, nil
+	return (*message)(dm).sendToChannel(ctx, s, options...)
 }
 
-// Edit edits the existing message using the provided Discord session and updates its content, components, embeds, and flags.
-func (dm *DirectMessage) Edit(s *discordgo.Session, options ...discordgo.RequestOption) error {
-	if dm.channelID == "" {
-		return ErrMissingChannelID
-	}
-	if dm.messageID == "" {
-		return ErrMissingMessageID
-	}
-	message := &discordgo.MessageEdit{
-		ID:         dm.messageID,
-		Channel:    dm.channelID,
-		Content:    &dm.content,
-		Components: &dm.components,
-		Embeds:     &dm.embeds,
-		Flags:      dm.flags,
-	}
-	_, err := s.ChannelMessageEditComplex(message, options...)
-	if err != nil {
-		return err
-	}
-
-	return nil
+// Edit edits the existing message using the provided Discord session. Only the fields changed since the message was
+// last sent, edited or hydrated are updated, so others are left untouched; use WithFullEdit to update them all.
+// Set fields to empty values, such as with ClearEmbeds, to remove them.
+func (dm *DirectMessage) Edit(s Sender, options ...discordgo.RequestOption) error {
+	return dm.EditContext(context.Background(), s, options...)
 }
 
-// Delete deletes the message using the provided Discord session and clears the MessageID to indicate it has been deleted.
-func (dm *DirectMessage) Delete(s *discordgo.Session, options ...discordgo.RequestOption) error {
-	if dm.channelID == "" {
-		return ErrMissingChannelID
-	}
-	if dm.messageID == "" {
-		return ErrMissingMessageID
-	}
-	err := s.ChannelMessageDelete(dm.channelID, dm.messageID, options...)
-	if err != nil {
-		return err
-	}
-	dm.messageID = "" // Clear the ID after deletion
-	return nil
+// EditContext is like Edit, but the requests are bound to the context and any pending retries are abandoned once the
+// context is done.
+func (dm *DirectMessage) EditContext(ctx context.Context, s Sender, options ...discordgo.RequestOption) error {
+	return (*message)(dm).editInChannel(ctx, s, options...)
 }
 
-// WithMemberID uses the member ID to create a new channel to the member and sets the channel ID
-// for the message.
-func (dm *DirectMessage) WithMemberID(s *discordgo.Session, memberID string) *DirectMessage {
-	channel, err := s.UserChannelCreate(memberID)
-	if err == nil {
-		dm.channelID = channel.ID
-	}
-	return dm
+// Delete deletes the message using the provided Discord session and clears the MessageID to indicate it has been deleted.
+func (dm *DirectMessage) Delete(s Sender, options ...discordgo.RequestOption) error {
+	return dm.DeleteContext(context.Background(), s, options...)
 }
 
-// WithMessageID sets the message ID for the message.
-func (dm *DirectMessage) WithMessageID(channelID string) *DirectMessage {
-	dm.channelID = channelID
-	return dm
+// DeleteContext is like Delete, but the requests are bound to the context and any pending retries are abandoned once
+// the context is done.
+func (dm *DirectMessage) DeleteContext(ctx context.Context, s Sender, options ...discordgo.RequestOption) error {
+	return (*message)(dm).deleteFromChannel(ctx, s, options...)
 }
 
-// WithContent sets the content for the message.
-func (dm *DirectMessage) WithContent(content string) *DirectMessage {
-	dm.content = content
+// WithMemberID uses the member ID to create a new channel to the member and sets the channel ID
+// for the message. The channel ID is left unset if the channel cannot be created; use WithMemberIDContext to
+// learn why.
+func (dm *DirectMessage) WithMemberID(s Sender, memberID string, options ...discordgo.RequestOption) *DirectMessage {
+	_ = dm.WithMemberIDContext(context.Background(), s, memberID, options...)
 	return dm
 }
 
-// WithEmbeds sets the embeds for the message.
-func (dm *DirectMessage) WithEmbeds(embeds []*discordgo.MessageEmbed) *DirectMessage {
-	dm.embeds = embeds
-	return dm
+// WithMemberIDContext is like WithMemberID, but the requests are bound to the context and any pending retries are
+// abandoned once the context is done. An error is returned if the channel cannot be created.
+func (dm *DirectMessage) WithMemberIDContext(ctx context.Context, s Sender, memberID string, options ...discordgo.RequestOption) error {
+	return dm.openChannel(ctx, s, memberID, options...)
 }
 
-// WithComponents sets the components for the message.
-func (dm *DirectMessage) WithComponents(components []discordgo.MessageComponent) *DirectMessage {
-	dm.components = components
-	return dm
+// openChannel creates the private channel to the member, or retrieves it if it already exists, and sets it as the
+// channel for the direct message.
+func (dm *DirectMessage) openChannel(ctx context.Context, s Sender, memberID string, options ...discordgo.RequestOption) error {
+	var channel *discordgo.Channel
+	err := (*message)(dm).do(ctx, "create DM channel", options, func(options ...discordgo.RequestOption) (err error) {
+		channel, err = s.UserChannelCreate(memberID, options...)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	dm.channelID = channel.ID
+	return nil
 }
@@ -1,6 +1,8 @@
 package disgomsg
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/bwmarrin/discordgo"
@@ -57,10 +59,13 @@ func TestNewDirectMessage(t *testing.T) {
 func TestDirectMessageWithMethods(t *testing.T) {
 	// Test WithMessageID
 	dm := NewDirectMessage()
-	channelID := "123456789"
-	dm = dm.WithMessageID(channelID)
-	if dm.channelID != channelID {
-		t.Errorf("Expected channelID %s, got %s", channelID, dm.channelID)
+	messageID := "123456789"
+	dm = dm.WithMessageID(messageID)
+	if dm.messageID != messageID {
+		t.Errorf("Expected messageID %s, got %s", messageID, dm.messageID)
+	}
+	if dm.channelID != "" {
+		t.Errorf("Expected channelID to be unchanged, got %s", dm.channelID)
 	}
 
 	// Test WithContent
@@ -89,10 +94,121 @@ func TestDirectMessageWithMethods(t *testing.T) {
 	}
 }
 
-// Note: We can't easily test Send, Edit, and Delete methods
-// without mocking the discordgo.Session interface, which is quite large.
-// In a real-world scenario, you might use a mocking library or create a test
-// wrapper around the discordgo.Session interface.
+func TestDirectMessageSendEditDelete(t *testing.T) {
+	rec := NewRecorder()
+	dm := NewDirectMessage(WithContent("hello"))
+
+	messageID, err := dm.Send(rec, "member-1")
+	if err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	requests := rec.Requests()
+	if len(requests) != 2 {
+		t.Fatalf("Expected 2 requests, got %d", len(requests))
+	}
+	if requests[0].Method != "UserChannelCreate" || requests[0].RecipientID != "member-1" {
+		t.Errorf("Unexpected channel request %+v", requests[0])
+	}
+	if requests[1].Method != "ChannelMessageSendComplex" || requests[1].MessageSend.Content != "hello" {
+		t.Errorf("Unexpected send request %+v", requests[1])
+	}
+	if dm.channelID == "" || dm.channelID != requests[1].ChannelID {
+		t.Errorf("Expected channelID %q, got %q", requests[1].ChannelID, dm.channelID)
+	}
+	if dm.messageID != messageID {
+		t.Errorf("Expected messageID %q, got %q", messageID, dm.messageID)
+	}
+
+	if err := dm.WithContent("updated").Edit(rec); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	req, _ := rec.Last()
+	if req.Method != "ChannelMessageEditComplex" || *req.MessageEdit.Content != "updated" {
+		t.Errorf("Unexpected edit request %+v", req)
+	}
+
+	if err := dm.Delete(rec); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	if dm.messageID != "" {
+		t.Errorf("Expected messageID to be cleared, got %q", dm.messageID)
+	}
+}
+
+func TestDirectMessageWithMemberID(t *testing.T) {
+	rec := NewRecorder()
+	dm := NewDirectMessage().WithMemberID(rec, "member-1")
+	req, _ := rec.Last()
+	if req.Method != "UserChannelCreate" || req.RecipientID != "member-1" {
+		t.Errorf("Unexpected request %+v", req)
+	}
+	if dm.channelID == "" {
+		t.Error("Expected channelID to be set")
+	}
 
-// Note: We also can't easily test WithMemberID method since it requires
-// a real discordgo.Session to create a user channel.
\ No newline at end of file
+	rec.Errors = map[string]error{"UserChannelCreate": errors.New("cannot create channel")}
+	dm = NewDirectMessage().WithMemberID(rec, "member-2")
+	if dm.channelID != "" {
+		t.Errorf("Expected empty channelID on failure, got %q", dm.channelID)
+	}
+}
+
+func TestDirectMessageWithMemberIDContext(t *testing.T) {
+	rec := NewRecorder()
+	dm := NewDirectMessage()
+	reason := discordgo.WithAuditLogReason("support")
+	if err := dm.WithMemberIDContext(context.Background(), rec, "member-1", reason); err != nil {
+		t.Fatalf("WithMemberIDContext returned error: %v", err)
+	}
+	if req := last(rec); req.Method != "UserChannelCreate" || req.RecipientID != "member-1" || len(req.Options) != 2 {
+		t.Errorf("Expected the channel to be created with the request options, got %+v", req)
+	}
+	if dm.channelID == "" {
+		t.Error("Expected channelID to be set")
+	}
+
+	channelErr := errors.New("cannot create channel")
+	rec.Errors = map[string]error{"UserChannelCreate": channelErr}
+	err := NewDirectMessage().WithMemberIDContext(context.Background(), rec, "member-2")
+	var apiErr *APIError
+	if !errors.Is(err, channelErr) || !errors.As(err, &apiErr) {
+		t.Errorf("Expected %v wrapped in *APIError, got %v", channelErr, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := NewDirectMessage().WithMemberIDContext(ctx, NewRecorder(), "member-3"); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func TestDirectMessageEditFields(t *testing.T) {
+	rec := NewRecorder()
+	mentions := &discordgo.MessageAllowedMentions{Users: []string{"member-1"}}
+	dm := NewDirectMessage(WithContent("hello"), WithEmbeds([]*discordgo.MessageEmbed{{Title: "Report"}}))
+	if _, err := dm.Send(rec, "member-1"); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+
+	files := []*discordgo.File{{Name: "report.txt"}}
+	WithFiles(files)((*message)(dm))
+	WithAllowedMentions(mentions)((*message)(dm))
+	ClearEmbeds()((*message)(dm))
+	if err := dm.Edit(rec); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	req, _ := rec.Last()
+	edit := req.MessageEdit
+	if edit.AllowedMentions != mentions || len(edit.Files) != 1 {
+		t.Errorf("Expected allowed mentions and files to be sent, got %+v", edit)
+	}
+	if edit.Embeds == nil || len(*edit.Embeds) != 0 {
+		t.Errorf("Expected embeds to be cleared, got %v", edit.Embeds)
+	}
+	if edit.Content != nil {
+		t.Errorf("Expected unchanged content to be left untouched, got %q", *edit.Content)
+	}
+	if edit.Attachments != nil {
+		t.Errorf("Expected existing attachments to be retained, got %v", edit.Attachments)
+	}
+}
@@ -0,0 +1,207 @@
+package disgomsg
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	ellipsis   = "…"
+	blankField = "\u200b"
+)
+
+// EmbedMode determines how an EmbedBuilder handles text that exceeds Discord's limits.
+type EmbedMode int
+
+const (
+	// EmbedTruncate truncates text that is too long, ending it with an ellipsis.
+	EmbedTruncate EmbedMode = iota
+	// EmbedStrict returns an error for text that is too long.
+	EmbedStrict
+)
+
+// EmbedBuilder is a fluent builder for Discord message embeds.
+type EmbedBuilder struct {
+	embed discordgo.MessageEmbed
+	mode  EmbedMode
+}
+
+// NewEmbedBuilder creates a new embed builder that truncates text exceeding Discord's limits.
+func NewEmbedBuilder() *EmbedBuilder {
+	return &EmbedBuilder{}
+}
+
+// WithMode sets how text exceeding Discord's limits is handled when the embed is built.
+func (b *EmbedBuilder) WithMode(mode EmbedMode) *EmbedBuilder {
+	b.mode = mode
+	return b
+}
+
+// WithTitle sets the title for the embed.
+func (b *EmbedBuilder) WithTitle(title string) *EmbedBuilder {
+	b.embed.Title = title
+	return b
+}
+
+// WithURL sets the URL the title links to.
+func (b *EmbedBuilder) WithURL(url string) *EmbedBuilder {
+	b.embed.URL = url
+	return b
+}
+
+// WithDescription sets the description for the embed.
+func (b *EmbedBuilder) WithDescription(description string) *EmbedBuilder {
+	b.embed.Description = description
+	return b
+}
+
+// WithColor sets the color for the embed.
+func (b *EmbedBuilder) WithColor(color int) *EmbedBuilder {
+	b.embed.Color = color
+	return b
+}
+
+// WithTimestamp sets the timestamp for the embed.
+func (b *EmbedBuilder) WithTimestamp(timestamp time.Time) *EmbedBuilder {
+	b.embed.Timestamp = timestamp.Format(time.RFC3339)
+	return b
+}
+
+// WithAuthor sets the author for the embed. The URL and icon URL are optional.
+func (b *EmbedBuilder) WithAuthor(name, url, iconURL string) *EmbedBuilder {
+	b.embed.Author = &discordgo.MessageEmbedAuthor{Name: name, URL: url, IconURL: iconURL}
+	return b
+}
+
+// WithFooter sets the footer for the embed. The icon URL is optional.
+func (b *EmbedBuilder) WithFooter(text, iconURL string) *EmbedBuilder {
+	b.embed.Footer = &discordgo.MessageEmbedFooter{Text: text, IconURL: iconURL}
+	return b
+}
+
+// WithThumbnail sets the thumbnail image for the embed.
+func (b *EmbedBuilder) WithThumbnail(url string) *EmbedBuilder {
+	b.embed.Thumbnail = &discordgo.MessageEmbedThumbnail{URL: url}
+	return b
+}
+
+// WithImage sets the image for the embed.
+func (b *EmbedBuilder) WithImage(url string) *EmbedBuilder {
+	b.embed.Image = &discordgo.MessageEmbedImage{URL: url}
+	return b
+}
+
+// AddField adds a field that is displayed on its own line.
+func (b *EmbedBuilder) AddField(name, value string) *EmbedBuilder {
+	b.embed.Fields = append(b.embed.Fields, &discordgo.MessageEmbedField{Name: name, Value: value})
+	return b
+}
+
+// AddInlineField adds a field that is displayed alongside adjacent inline fields.
+func (b *EmbedBuilder) AddInlineField(name, value string) *EmbedBuilder {
+	b.embed.Fields = append(b.embed.Fields, &discordgo.MessageEmbedField{Name: name, Value: value, Inline: true})
+	return b
+}
+
+// AddBlankField adds an empty field, which may be used to control the layout of inline fields.
+func (b *EmbedBuilder) AddBlankField(inline bool) *EmbedBuilder {
+	b.embed.Fields = append(b.embed.Fields, &discordgo.MessageEmbedField{Name: blankField, Value: blankField, Inline: inline})
+	return b
+}
+
+// Build creates the embed. Text exceeding Discord's limits is truncated or reported as an error, depending on
+// the mode. Too many fields, or a combined length over Discord's limit, are always reported as errors.
+func (b *EmbedBuilder) Build() (*discordgo.MessageEmbed, error) {
+	v := &validator{}
+	embed := b.embed
+	embed.Title = b.limit(v, "title", embed.Title, MaxEmbedTitleLength)
+	embed.Description = b.limit(v, "description", embed.Description, MaxEmbedDescriptionLength)
+	if embed.Author != nil {
+		author := *embed.Author
+		author.Name = b.limit(v, "author.name", author.Name, MaxEmbedAuthorNameLength)
+		embed.Author = &author
+	}
+	if embed.Footer != nil {
+		footer := *embed.Footer
+		footer.Text = b.limit(v, "footer.text", footer.Text, MaxEmbedFooterLength)
+		embed.Footer = &footer
+	}
+	v.maxCount("fields", len(embed.Fields), MaxEmbedFields)
+	if embed.Fields != nil {
+		embed.Fields = make([]*discordgo.MessageEmbedField, 0, len(b.embed.Fields))
+		for i, f := range b.embed.Fields {
+			field := *f
+			field.Name = b.limit(v, fmt.Sprintf("fields[%d].name", i), field.Name, MaxEmbedFieldNameLength)
+			field.Value = b.limit(v, fmt.Sprintf("fields[%d].value", i), field.Value, MaxEmbedFieldValueLength)
+			embed.Fields = append(embed.Fields, &field)
+		}
+	}
+	if total := embedLength(&embed); total > MaxEmbedTotalLength {
+		v.add("embed", "combined length %d exceeds maximum of %d", total, MaxEmbedTotalLength)
+	}
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	return &embed, nil
+}
+
+// limit applies the builder's mode to text exceeding max characters.
+func (b *EmbedBuilder) limit(v *validator, field string, s string, max int) string {
+	if b.mode == EmbedStrict {
+		v.maxLength(field, s, max)
+		return s
+	}
+	return truncate(s, max)
+}
+
+// BuildEmbeds builds each of the embed builders, returning embeds that may be passed to WithEmbeds. Errors from
+// all builders are joined together.
+func BuildEmbeds(builders ...*EmbedBuilder) ([]*discordgo.MessageEmbed, error) {
+	embeds := make([]*discordgo.MessageEmbed, 0, len(builders))
+	var errs []error
+	for i, b := range builders {
+		embed, err := b.Build()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("embeds[%d]: %w", i, err))
+			continue
+		}
+		embeds = append(embeds, embed)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return embeds, nil
+}
+
+// embedLength returns the number of characters in the embed that count towards Discord's combined embed limit.
+func embedLength(embed *discordgo.MessageEmbed) int {
+	n := utf8.RuneCountInString(embed.Title) + utf8.RuneCountInString(embed.Description)
+	if embed.Author != nil {
+		n += utf8.RuneCountInString(embed.Author.Name)
+	}
+	if embed.Footer != nil {
+		n += utf8.RuneCountInString(embed.Footer.Text)
+	}
+	for _, f := range embed.Fields {
+		if f != nil {
+			n += utf8.RuneCountInString(f.Name) + utf8.RuneCountInString(f.Value)
+		}
+	}
+	return n
+}
+
+// truncate shortens the string to at most max characters, replacing the final character with an ellipsis if the
+// string was shortened.
+func truncate(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	if max <= 0 {
+		return ""
+	}
+	return s[:byteOffset(s, max-1)] + ellipsis
+}
@@ -0,0 +1,141 @@
+package disgomsg
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestEmbedBuilder(t *testing.T) {
+	timestamp := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	embed, err := NewEmbedBuilder().
+		WithTitle("Title").
+		WithURL("https://example.com").
+		WithDescription("Description").
+		WithColor(0x00ff00).
+		WithTimestamp(timestamp).
+		WithAuthor("Author", "https://example.com/author", "https://example.com/author.png").
+		WithFooter("Footer", "https://example.com/footer.png").
+		WithThumbnail("https://example.com/thumb.png").
+		WithImage("https://example.com/image.png").
+		AddField("Name", "Value").
+		AddInlineField("Inline", "Value").
+		AddBlankField(true).
+		Build()
+	if err != nil {
+		t.Fatalf("Build returned error: %v", err)
+	}
+
+	if embed.Title != "Title" || embed.URL != "https://example.com" || embed.Description != "Description" {
+		t.Errorf("Unexpected title, URL or description: %+v", embed)
+	}
+	if embed.Color != 0x00ff00 {
+		t.Errorf("Expected color %x, got %x", 0x00ff00, embed.Color)
+	}
+	if embed.Timestamp != "2024-01-02T03:04:05Z" {
+		t.Errorf("Unexpected timestamp %q", embed.Timestamp)
+	}
+	if embed.Author == nil || embed.Author.Name != "Author" || embed.Author.IconURL != "https://example.com/author.png" {
+		t.Errorf("Unexpected author %+v", embed.Author)
+	}
+	if embed.Footer == nil || embed.Footer.Text != "Footer" {
+		t.Errorf("Unexpected footer %+v", embed.Footer)
+	}
+	if embed.Thumbnail == nil || embed.Image == nil {
+		t.Error("Expected thumbnail and image to be set")
+	}
+	if len(embed.Fields) != 3 {
+		t.Fatalf("Expected 3 fields, got %d", len(embed.Fields))
+	}
+	if embed.Fields[0].Inline || !embed.Fields[1].Inline || !embed.Fields[2].Inline {
+		t.Error("Unexpected inline settings on fields")
+	}
+	if embed.Fields[2].Name != blankField || embed.Fields[2].Value != blankField {
+		t.Errorf("Expected blank field, got %+v", embed.Fields[2])
+	}
+}
+
+func TestEmbedBuilderTruncate(t *testing.T) {
+	b := NewEmbedBuilder().
+		WithTitle(strings.Repeat("t", MaxEmbedTitleLength+10)).
+		AddField("name", strings.Repeat("é", MaxEmbedFieldValueLength+1))
+	embed, err := b.Build()
+	if err != nil {
+		t.Fatalf("Build returned error: %v", err)
+	}
+	if n := utf8.RuneCountInString(embed.Title); n != MaxEmbedTitleLength {
+		t.Errorf("Expected title length %d, got %d", MaxEmbedTitleLength, n)
+	}
+	if !strings.HasSuffix(embed.Title, ellipsis) {
+		t.Errorf("Expected title to end with an ellipsis")
+	}
+	if n := utf8.RuneCountInString(embed.Fields[0].Value); n != MaxEmbedFieldValueLength {
+		t.Errorf("Expected field value length %d, got %d", MaxEmbedFieldValueLength, n)
+	}
+
+	// Building does not modify the builder
+	if n := utf8.RuneCountInString(b.embed.Title); n != MaxEmbedTitleLength+10 {
+		t.Errorf("Expected builder title to be unchanged, got length %d", n)
+	}
+}
+
+func TestEmbedBuilderStrict(t *testing.T) {
+	_, err := NewEmbedBuilder().
+		WithMode(EmbedStrict).
+		WithTitle(strings.Repeat("t", MaxEmbedTitleLength+1)).
+		WithFooter(strings.Repeat("f", MaxEmbedFooterLength+1), "").
+		Build()
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("Expected validation error, got %v", err)
+	}
+	fields := validationFields(err)
+	if !hasField(fields, "title") || !hasField(fields, "footer.text") {
+		t.Errorf("Expected title and footer violations, got %v", fields)
+	}
+}
+
+func TestEmbedBuilderTooManyFields(t *testing.T) {
+	b := NewEmbedBuilder()
+	for i := 0; i <= MaxEmbedFields; i++ {
+		b.AddField("name", "value")
+	}
+	if _, err := b.Build(); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+}
+
+func TestBuildEmbeds(t *testing.T) {
+	embeds, err := BuildEmbeds(
+		NewEmbedBuilder().WithTitle("One"),
+		NewEmbedBuilder().WithTitle("Two"),
+	)
+	if err != nil {
+		t.Fatalf("BuildEmbeds returned error: %v", err)
+	}
+	msg := NewMessage(WithEmbeds(embeds))
+	if len(msg.embeds) != 2 || msg.embeds[1].Title != "Two" {
+		t.Errorf("Unexpected embeds %v", msg.embeds)
+	}
+
+	_, err = BuildEmbeds(
+		NewEmbedBuilder().WithTitle("One"),
+		NewEmbedBuilder().WithMode(EmbedStrict).WithTitle(strings.Repeat("t", MaxEmbedTitleLength+1)),
+	)
+	if !errors.Is(err, ErrValidation) || !strings.Contains(err.Error(), "embeds[1]") {
+		t.Errorf("Expected validation error for embeds[1], got %v", err)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	if got := truncate("hello", 5); got != "hello" {
+		t.Errorf("Expected %q, got %q", "hello", got)
+	}
+	if got := truncate("hello world", 5); got != "hell"+ellipsis {
+		t.Errorf("Expected %q, got %q", "hell"+ellipsis, got)
+	}
+	if got := truncate("hello", 0); got != "" {
+		t.Errorf("Expected empty string, got %q", got)
+	}
+}
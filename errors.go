@@ -1,8 +1,224 @@
 package disgomsg
 
-import "errors"
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
 
 var (
-	ErrMissingChannelID = errors.New("missing channel ID")
-	ErrMissingMessageID = errors.New("missing message ID")
+	ErrMissingChannelID   = errors.New("missing channel ID")
+	ErrMissingMessageID   = errors.New("missing message ID")
+	ErrMissingInteraction = errors.New("missing interaction")
+	ErrMissingWebhook     = errors.New("missing webhook ID or token")
+	ErrInvalidWebhookURL  = errors.New("invalid webhook URL")
+	ErrMissingThreadName  = errors.New("missing thread name")
+	ErrUnknownForumTag    = errors.New("unknown forum tag")
+	ErrAlreadyResponded   = errors.New("interaction has already been responded to")
+	ErrNotResponded       = errors.New("interaction has not been responded to")
+	ErrResponseDeleted    = errors.New("interaction response has been deleted")
+	ErrInteractionExpired = errors.New("interaction token has expired")
+	ErrValidation         = errors.New("validation failed")
+	ErrLayout             = errors.New("invalid component layout")
+	ErrNotModalSubmit     = errors.New("interaction is not a modal submission")
+	ErrFieldRequired      = errors.New("a value is required")
 )
+
+// ValidationError reports a single violation of a Discord limit found when validating a message.
+type ValidationError struct {
+	Field  string // Path to the offending field, such as "embeds[0].fields[2].value".
+	Reason string
+}
+
+// Error returns the field path and the reason for the violation.
+func (e *ValidationError) Error() string {
+	return e.Field + ": " + e.Reason
+}
+
+// Is reports whether the target is ErrValidation, so that any validation error may be matched with errors.Is.
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// Errors for common Discord error codes, which may be matched against an *APIError with errors.Is.
+var (
+	ErrUnknownChannel      = errors.New("unknown channel")
+	ErrUnknownMessage      = errors.New("unknown message")
+	ErrUnknownUser         = errors.New("unknown user")
+	ErrUnknownWebhook      = errors.New("unknown webhook")
+	ErrUnknownInteraction  = errors.New("unknown interaction")
+	ErrAlreadyAcknowledged = errors.New("interaction has already been acknowledged")
+	ErrMissingAccess       = errors.New("missing access")
+	ErrCannotSendEmpty     = errors.New("cannot send an empty message")
+	ErrCannotMessageUser   = errors.New("cannot send messages to this user")
+	ErrMissingPermissions  = errors.New("missing permissions")
+	ErrInvalidFormBody     = errors.New("invalid form body")
+	ErrRateLimited         = errors.New("rate limited")
+)
+
+// errorCodes maps Discord error codes to the matching sentinel errors.
+var errorCodes = map[int]error{
+	discordgo.ErrCodeUnknownChannel:                        ErrUnknownChannel,
+	discordgo.ErrCodeUnknownMessage:                        ErrUnknownMessage,
+	discordgo.ErrCodeUnknownUser:                           ErrUnknownUser,
+	discordgo.ErrCodeUnknownWebhook:                        ErrUnknownWebhook,
+	discordgo.ErrCodeUnknownInteraction:                    ErrUnknownInteraction,
+	discordgo.ErrCodeInteractionHasAlreadyBeenAcknowledged: ErrAlreadyAcknowledged,
+	discordgo.ErrCodeMissingAccess:                         ErrMissingAccess,
+	discordgo.ErrCodeCannotSendEmptyMessage:                ErrCannotSendEmpty,
+	discordgo.ErrCodeCannotSendMessagesToThisUser:          ErrCannotMessageUser,
+	discordgo.ErrCodeMissingPermissions:                    ErrMissingPermissions,
+	discordgo.ErrCodeInvalidFormBody:                       ErrInvalidFormBody,
+}
+
+// APIError is returned when a request to Discord fails. It identifies the operation and the channel, message and
+// interaction involved, along with the details Discord returned for a REST failure.
+type APIError struct {
+	Op            string // The operation that failed, such as "send message".
+	ChannelID     string
+	MessageID     string
+	InteractionID string
+	StatusCode    int    // HTTP status code, or zero if no response was received.
+	Code          int    // Discord JSON error code, or zero if none was returned.
+	Message       string // Discord error message, if any.
+	FieldErrors   []APIFieldError
+	Err           error // The underlying error, usually a *discordgo.RESTError.
+}
+
+// APIFieldError is a validation error Discord reported for a single field of a request.
+type APIFieldError struct {
+	Field   string // Path to the field, such as "embeds.0.title".
+	Code    string
+	Message string
+}
+
+// Error describes the failed operation and the reason for the failure.
+func (e *APIError) Error() string {
+	var sb strings.Builder
+	sb.WriteString("disgomsg: " + e.Op)
+	if e.ChannelID != "" {
+		sb.WriteString(" channel=" + e.ChannelID)
+	}
+	if e.MessageID != "" {
+		sb.WriteString(" message=" + e.MessageID)
+	}
+	if e.InteractionID != "" {
+		sb.WriteString(" interaction=" + e.InteractionID)
+	}
+	sb.WriteString(": ")
+	if e.StatusCode == 0 && e.Code == 0 {
+		sb.WriteString(e.Err.Error())
+		return sb.String()
+	}
+	sb.WriteString("HTTP " + strconv.Itoa(e.StatusCode))
+	if e.Code != 0 {
+		sb.WriteString(", code " + strconv.Itoa(e.Code))
+	}
+	if e.Message != "" {
+		sb.WriteString(": " + e.Message)
+	}
+	for _, f := range e.FieldErrors {
+		sb.WriteString("; " + f.Field + ": " + f.Message)
+	}
+	return sb.String()
+}
+
+// Unwrap returns the underlying error.
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether the target is the sentinel error for the Discord error code or HTTP status of the failure.
+func (e *APIError) Is(target error) bool {
+	if target == ErrRateLimited {
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	sentinel, ok := errorCodes[e.Code]
+	return ok && sentinel == target
+}
+
+// wrapError wraps an error returned by Discord for the operation on the message in an *APIError. A nil error is
+// returned unchanged.
+func wrapError(err error, op string, m *message) error {
+	if err == nil {
+		return nil
+	}
+	apiErr := &APIError{
+		Op:        op,
+		ChannelID: m.channelID,
+		MessageID: m.messageID,
+		Err:       err,
+	}
+	if m.interaction != nil {
+		apiErr.InteractionID = m.interaction.ID
+	}
+
+	var rateLimitErr *discordgo.RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		apiErr.StatusCode = http.StatusTooManyRequests
+		if rateLimitErr.RateLimit != nil && rateLimitErr.TooManyRequests != nil {
+			apiErr.Message = rateLimitErr.Message
+		}
+	}
+	var restErr *discordgo.RESTError
+	if errors.As(err, &restErr) {
+		if restErr.Response != nil {
+			apiErr.StatusCode = restErr.Response.StatusCode
+		}
+		if restErr.Message != nil {
+			apiErr.Code = restErr.Message.Code
+			apiErr.Message = restErr.Message.Message
+		}
+		apiErr.FieldErrors = parseFieldErrors(restErr.ResponseBody)
+	}
+	return apiErr
+}
+
+// parseFieldErrors extracts the field-level errors from the body of a Discord error response, which nests them by
+// field path under an "errors" object.
+func parseFieldErrors(body []byte) []APIFieldError {
+	var response struct {
+		Errors map[string]json.RawMessage `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil || len(response.Errors) == 0 {
+		return nil
+	}
+	var fieldErrors []APIFieldError
+	collectFieldErrors("", response.Errors, &fieldErrors)
+	sort.Slice(fieldErrors, func(i, j int) bool {
+		return fieldErrors[i].Field < fieldErrors[j].Field
+	})
+	return fieldErrors
+}
+
+// collectFieldErrors walks the nested error object, appending each error found to fieldErrors.
+func collectFieldErrors(path string, node map[string]json.RawMessage, fieldErrors *[]APIFieldError) {
+	for key, raw := range node {
+		if key == "_errors" {
+			var errs []struct {
+				Code    string `json:"code"`
+				Message string `json:"message"`
+			}
+			if json.Unmarshal(raw, &errs) == nil {
+				for _, e := range errs {
+					*fieldErrors = append(*fieldErrors, APIFieldError{Field: path, Code: e.Code, Message: e.Message})
+				}
+			}
+			continue
+		}
+		var child map[string]json.RawMessage
+		if json.Unmarshal(raw, &child) != nil {
+			continue
+		}
+		childPath := key
+		if path != "" {
+			childPath = path + "." + key
+		}
+		collectFieldErrors(childPath, child, fieldErrors)
+	}
+}
@@ -2,7 +2,11 @@ package disgomsg
 
 import (
 	"errors"
+	"net/http"
+	"strings"
 	"testing"
+
+	"github.com/bwmarrin/discordgo"
 )
 
 func TestErrors(t *testing.T) {
@@ -36,4 +40,118 @@ func TestErrors(t *testing.T) {
 	if errors.Is(wrappedErr, ErrMissingChannelID) {
 		t.Error("errors.Is should return false for wrapped errors (without using fmt.Errorf)")
 	}
-}
\ No newline at end of file
+}
+
+func TestValidationError(t *testing.T) {
+	err := &ValidationError{Field: "embeds[0].title", Reason: "too long"}
+	if err.Error() != "embeds[0].title: too long" {
+		t.Errorf("Unexpected error message %q", err.Error())
+	}
+	if !errors.Is(err, ErrValidation) {
+		t.Error("Expected ValidationError to match ErrValidation")
+	}
+	if !errors.Is(errors.Join(err), ErrValidation) {
+		t.Error("Expected joined ValidationError to match ErrValidation")
+	}
+}
+
+// restError creates a REST error as returned by discordgo for the status, Discord error code and response body.
+func restError(status int, code int, body string) *discordgo.RESTError {
+	return &discordgo.RESTError{
+		Response:     &http.Response{StatusCode: status, Status: http.StatusText(status)},
+		ResponseBody: []byte(body),
+		Message:      &discordgo.APIErrorMessage{Code: code, Message: "error message"},
+	}
+}
+
+func TestWrapError(t *testing.T) {
+	body := `{"code": 50035, "message": "Invalid Form Body", "errors": {"content": {"_errors": [{"code": "BASE_TYPE_MAX_LENGTH", "message": "Must be 2000 or fewer in length."}]}, "embeds": {"0": {"title": {"_errors": [{"code": "BASE_TYPE_REQUIRED", "message": "This field is required"}]}}}}}`
+	m := &message{channelID: "channel-1", messageID: "message-1", interaction: &discordgo.Interaction{ID: "interaction-1"}}
+	err := wrapError(restError(http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, body), "send message", m)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %T", err)
+	}
+	if apiErr.Op != "send message" || apiErr.ChannelID != "channel-1" || apiErr.MessageID != "message-1" || apiErr.InteractionID != "interaction-1" {
+		t.Errorf("Unexpected operation details %+v", apiErr)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest || apiErr.Code != discordgo.ErrCodeInvalidFormBody {
+		t.Errorf("Unexpected status %d or code %d", apiErr.StatusCode, apiErr.Code)
+	}
+	expected := []APIFieldError{
+		{Field: "content", Code: "BASE_TYPE_MAX_LENGTH", Message: "Must be 2000 or fewer in length."},
+		{Field: "embeds.0.title", Code: "BASE_TYPE_REQUIRED", Message: "This field is required"},
+	}
+	if len(apiErr.FieldErrors) != len(expected) {
+		t.Fatalf("Expected %d field errors, got %+v", len(expected), apiErr.FieldErrors)
+	}
+	for i := range expected {
+		if apiErr.FieldErrors[i] != expected[i] {
+			t.Errorf("Expected field error %+v, got %+v", expected[i], apiErr.FieldErrors[i])
+		}
+	}
+	if !errors.Is(err, ErrInvalidFormBody) || errors.Is(err, ErrUnknownMessage) {
+		t.Error("Expected error to match ErrInvalidFormBody only")
+	}
+	var restErr *discordgo.RESTError
+	if !errors.As(err, &restErr) {
+		t.Error("Expected the underlying *discordgo.RESTError to be available")
+	}
+	if msg := err.Error(); !strings.Contains(msg, "send message") || !strings.Contains(msg, "code 50035") || !strings.Contains(msg, "embeds.0.title") {
+		t.Errorf("Unexpected error message %q", msg)
+	}
+
+	if wrapError(nil, "send message", m) != nil {
+		t.Error("Expected nil error to be returned unchanged")
+	}
+}
+
+func TestAPIErrorSentinels(t *testing.T) {
+	tests := []struct {
+		status   int
+		code     int
+		sentinel error
+	}{
+		{http.StatusNotFound, discordgo.ErrCodeUnknownMessage, ErrUnknownMessage},
+		{http.StatusNotFound, discordgo.ErrCodeUnknownChannel, ErrUnknownChannel},
+		{http.StatusNotFound, discordgo.ErrCodeUnknownInteraction, ErrUnknownInteraction},
+		{http.StatusForbidden, discordgo.ErrCodeCannotSendMessagesToThisUser, ErrCannotMessageUser},
+		{http.StatusForbidden, discordgo.ErrCodeMissingPermissions, ErrMissingPermissions},
+		{http.StatusTooManyRequests, 0, ErrRateLimited},
+	}
+	for _, tt := range tests {
+		err := wrapError(restError(tt.status, tt.code, "{}"), "op", &message{})
+		if !errors.Is(err, tt.sentinel) {
+			t.Errorf("Expected code %d to match %v", tt.code, tt.sentinel)
+		}
+	}
+}
+
+func TestWrapNetworkError(t *testing.T) {
+	netErr := errors.New("connection refused")
+	err := wrapError(netErr, "delete message", &message{channelID: "channel-1"})
+	if !errors.Is(err, netErr) {
+		t.Error("Expected the network error to be wrapped")
+	}
+	if err.Error() != "disgomsg: delete message channel=channel-1: connection refused" {
+		t.Errorf("Unexpected error message %q", err.Error())
+	}
+}
+
+func TestSendReturnsAPIError(t *testing.T) {
+	rec := NewRecorder()
+	rec.Errors = map[string]error{"ChannelMessageSendComplex": restError(http.StatusForbidden, discordgo.ErrCodeCannotSendMessagesToThisUser, "{}")}
+	_, err := NewDirectMessage(WithContent("hello")).Send(rec, "member-1")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %v", err)
+	}
+	if apiErr.ChannelID == "" || apiErr.Op != "send message" {
+		t.Errorf("Unexpected operation details %+v", apiErr)
+	}
+	if !errors.Is(err, ErrCannotMessageUser) {
+		t.Error("Expected error to match ErrCannotMessageUser")
+	}
+}
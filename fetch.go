@@ -0,0 +1,55 @@
+package disgomsg
+
+import (
+	"context"
+	"slices"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// FromDiscordMessage creates a message from one that already exists in Discord, populating its content, embeds,
+// components, attachments, flags, reference, channel ID and message ID. Editing the returned message after a
+// partial modification preserves everything that was not changed. Flags that cannot be set when editing a message
+// are dropped.
+func FromDiscordMessage(msg *discordgo.Message) *Message {
+	m := NewMessage()
+	if msg == nil {
+		return m
+	}
+	m.attachments = slices.Clone(msg.Attachments)
+	m.channelID = msg.ChannelID
+	m.components = slices.Clone(msg.Components)
+	m.content = msg.Content
+	m.embeds = slices.Clone(msg.Embeds)
+	m.flags = msg.Flags & discordgo.MessageFlagsSuppressEmbeds
+	m.messageID = msg.ID
+	m.reference = msg.MessageReference
+	return m
+}
+
+// Fetch retrieves an existing message from Discord, so that it may be modified and edited without losing any of
+// its content.
+func Fetch(s MessageFetcher, channelID, messageID string, options ...discordgo.RequestOption) (*Message, error) {
+	return FetchContext(context.Background(), s, channelID, messageID, options...)
+}
+
+// FetchContext is like Fetch, but the requests are bound to the context and any pending retries are abandoned once
+// the context is done.
+func FetchContext(ctx context.Context, s MessageFetcher, channelID, messageID string, options ...discordgo.RequestOption) (*Message, error) {
+	if channelID == "" {
+		return nil, ErrMissingChannelID
+	}
+	if messageID == "" {
+		return nil, ErrMissingMessageID
+	}
+	m := &message{channelID: channelID, messageID: messageID}
+	var msg *discordgo.Message
+	err := m.do(ctx, "fetch message", options, func(options ...discordgo.RequestOption) (err error) {
+		msg, err = s.ChannelMessage(channelID, messageID, options...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return FromDiscordMessage(msg), nil
+}
@@ -0,0 +1,103 @@
+package disgomsg
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// existingMessage returns a message as it might be returned by Discord.
+func existingMessage() *discordgo.Message {
+	return &discordgo.Message{
+		ID:          "message-1",
+		ChannelID:   "channel-1",
+		Content:     "original content",
+		Embeds:      []*discordgo.MessageEmbed{{Title: "Original"}},
+		Attachments: []*discordgo.MessageAttachment{{ID: "attachment-1", Filename: "report.txt"}},
+		Components: []discordgo.MessageComponent{&discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			&discordgo.Button{Style: discordgo.PrimaryButton, Label: "OK", CustomID: "ok"},
+		}}},
+		Flags:            discordgo.MessageFlagsSuppressEmbeds | discordgo.MessageFlagsHasThread,
+		MessageReference: &discordgo.MessageReference{MessageID: "message-0", ChannelID: "channel-1"},
+	}
+}
+
+func TestFromDiscordMessage(t *testing.T) {
+	msg := existingMessage()
+	m := FromDiscordMessage(msg)
+	if m.messageID != "message-1" || m.channelID != "channel-1" {
+		t.Errorf("Expected message-1 in channel-1, got %q in %q", m.messageID, m.channelID)
+	}
+	if m.content != "original content" || len(m.embeds) != 1 || len(m.components) != 1 || len(m.attachments) != 1 {
+		t.Errorf("Expected content, embeds, components and attachments to be populated, got %+v", m)
+	}
+	if m.flags != discordgo.MessageFlagsSuppressEmbeds {
+		t.Errorf("Expected only the suppress embeds flag, got %v", m.flags)
+	}
+	if m.reference != msg.MessageReference {
+		t.Errorf("Expected reference to be populated")
+	}
+
+	// Modifying the message must not modify the original.
+	m.WithEmbeds(append(m.embeds, &discordgo.MessageEmbed{Title: "Added"}))
+	if len(msg.Embeds) != 1 {
+		t.Errorf("Expected original embeds to be unchanged, got %d", len(msg.Embeds))
+	}
+
+	if m := FromDiscordMessage(nil); m == nil || m.messageID != "" {
+		t.Errorf("Expected an empty message from nil, got %+v", m)
+	}
+}
+
+func TestFromDiscordMessagePartialEdit(t *testing.T) {
+	rec := NewRecorder()
+	m := FromDiscordMessage(existingMessage()).WithContent("updated content")
+	if err := m.Edit(rec); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	req, _ := rec.Last()
+	edit := req.MessageEdit
+	if edit.ID != "message-1" || edit.Channel != "channel-1" {
+		t.Errorf("Expected edit of message-1 in channel-1, got %q in %q", edit.ID, edit.Channel)
+	}
+	if *edit.Content != "updated content" {
+		t.Errorf("Expected updated content, got %q", *edit.Content)
+	}
+	if edit.Embeds != nil || edit.Components != nil || edit.Attachments != nil {
+		t.Errorf("Expected embeds, components and attachments to be left untouched, got %+v", edit)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	rec := NewRecorder()
+	rec.Messages = map[string]*discordgo.Message{"message-1": existingMessage()}
+
+	m, err := Fetch(rec, "channel-1", "message-1")
+	if err != nil {
+		t.Fatalf("Fetch returned error: %v", err)
+	}
+	if m.messageID != "message-1" || m.content != "original content" {
+		t.Errorf("Unexpected message %+v", m)
+	}
+	req, _ := rec.Last()
+	if req.Method != "ChannelMessage" || req.ChannelID != "channel-1" || req.MessageID != "message-1" {
+		t.Errorf("Unexpected request %+v", req)
+	}
+
+	_, err = Fetch(rec, "channel-2", "message-1")
+	if !errors.Is(err, ErrUnknownMessage) {
+		t.Errorf("Expected ErrUnknownMessage for the wrong channel, got %v", err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Op != "fetch message" || apiErr.StatusCode != 404 {
+		t.Errorf("Expected *APIError for fetch message, got %v", err)
+	}
+
+	if _, err := Fetch(rec, "", "message-1"); !errors.Is(err, ErrMissingChannelID) {
+		t.Errorf("Expected ErrMissingChannelID, got %v", err)
+	}
+	if _, err := Fetch(rec, "channel-1", ""); !errors.Is(err, ErrMissingMessageID) {
+		t.Errorf("Expected ErrMissingMessageID, got %v", err)
+	}
+}
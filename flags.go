@@ -0,0 +1,162 @@
+package disgomsg
+
+import (
+	"github.com/bwmarrin/discordgo"
+)
+
+// Message flags accepted by Discord for each kind of request. Any other flags are rejected by validation.
+const (
+	// channelSendFlags are accepted when sending a message to a channel.
+	channelSendFlags = discordgo.MessageFlagsSuppressEmbeds | discordgo.MessageFlagsSuppressNotifications
+	// channelEditFlags are accepted when editing a message in a channel.
+	channelEditFlags = discordgo.MessageFlagsSuppressEmbeds
+	// interactionFlags are accepted when responding to an interaction or sending a follow-up message.
+	interactionFlags = channelSendFlags | discordgo.MessageFlagsEphemeral
+)
+
+// WithEphemeral sets whether the message is only visible to the user who invoked the interaction. It applies to
+// interaction responses and follow-up messages only.
+func WithEphemeral(ephemeral bool) Option {
+	return withFlag(discordgo.MessageFlagsEphemeral, ephemeral)
+}
+
+// WithSuppressEmbeds sets whether embeds generated from links in the content are hidden.
+func WithSuppressEmbeds(suppress bool) Option {
+	return withFlag(discordgo.MessageFlagsSuppressEmbeds, suppress)
+}
+
+// WithSuppressNotifications sets whether sending the message skips push and desktop notifications.
+func WithSuppressNotifications(suppress bool) Option {
+	return withFlag(discordgo.MessageFlagsSuppressNotifications, suppress)
+}
+
+// withFlag returns an option that sets or clears the flag, leaving any other flags unchanged.
+func withFlag(flag discordgo.MessageFlags, set bool) Option {
+	return func(f *message) {
+		f.setFlag(flag, set)
+	}
+}
+
+// setFlag sets or clears the flag. Setting a flag that is already set, or clearing one that is not, has no effect.
+func (m *message) setFlag(flag discordgo.MessageFlags, set bool) {
+	flags := m.flags &^ flag
+	if set {
+		flags |= flag
+	}
+	if flags != m.flags {
+		m.flags = flags
+		m.changed |= fieldFlags
+	}
+}
+
+// HasFlags reports whether all of the flags are set on the message.
+func (m *Message) HasFlags(flags discordgo.MessageFlags) bool {
+	return m.flags&flags == flags
+}
+
+// SetSuppressEmbeds sets whether embeds generated from links in the content are hidden.
+func (m *Message) SetSuppressEmbeds(suppress bool) *Message {
+	return m.With(WithSuppressEmbeds(suppress))
+}
+
+// SetSuppressNotifications sets whether sending the message skips push and desktop notifications.
+func (m *Message) SetSuppressNotifications(suppress bool) *Message {
+	return m.With(WithSuppressNotifications(suppress))
+}
+
+// HasFlags reports whether all of the flags are set on the direct message.
+func (dm *DirectMessage) HasFlags(flags discordgo.MessageFlags) bool {
+	return dm.flags&flags == flags
+}
+
+// SetSuppressEmbeds sets whether embeds generated from links in the content are hidden.
+func (dm *DirectMessage) SetSuppressEmbeds(suppress bool) *DirectMessage {
+	return dm.With(WithSuppressEmbeds(suppress))
+}
+
+// SetSuppressNotifications sets whether sending the message skips push and desktop notifications.
+func (dm *DirectMessage) SetSuppressNotifications(suppress bool) *DirectMessage {
+	return dm.With(WithSuppressNotifications(suppress))
+}
+
+// HasFlags reports whether all of the flags are set on the response.
+func (r *Response) HasFlags(flags discordgo.MessageFlags) bool {
+	return r.flags&flags == flags
+}
+
+// IsEphemeral reports whether the response is only visible to the user who invoked the interaction.
+func (r *Response) IsEphemeral() bool {
+	return r.HasFlags(discordgo.MessageFlagsEphemeral)
+}
+
+// SetEphemeral sets whether the response is only visible to the user who invoked the interaction. Setting it more
+// than once has no further effect.
+func (r *Response) SetEphemeral(ephemeral bool) *Response {
+	return r.With(WithEphemeral(ephemeral))
+}
+
+// SetSuppressEmbeds sets whether embeds generated from links in the content are hidden.
+func (r *Response) SetSuppressEmbeds(suppress bool) *Response {
+	return r.With(WithSuppressEmbeds(suppress))
+}
+
+// SetSuppressNotifications sets whether sending the response skips push and desktop notifications.
+func (r *Response) SetSuppressNotifications(suppress bool) *Response {
+	return r.With(WithSuppressNotifications(suppress))
+}
+
+// HasFlags reports whether all of the flags are set on the follow-up message.
+func (f *Followup) HasFlags(flags discordgo.MessageFlags) bool {
+	return f.flags&flags == flags
+}
+
+// IsEphemeral reports whether the follow-up message is only visible to the user who invoked the interaction.
+func (f *Followup) IsEphemeral() bool {
+	return f.HasFlags(discordgo.MessageFlagsEphemeral)
+}
+
+// SetEphemeral sets whether the follow-up message is only visible to the user who invoked the interaction. Setting
+// it more than once has no further effect.
+func (f *Followup) SetEphemeral(ephemeral bool) *Followup {
+	return f.With(WithEphemeral(ephemeral))
+}
+
+// SetSuppressEmbeds sets whether embeds generated from links in the content are hidden.
+func (f *Followup) SetSuppressEmbeds(suppress bool) *Followup {
+	return f.With(WithSuppressEmbeds(suppress))
+}
+
+// SetSuppressNotifications sets whether sending the follow-up message skips push and desktop notifications.
+func (f *Followup) SetSuppressNotifications(suppress bool) *Followup {
+	return f.With(WithSuppressNotifications(suppress))
+}
+
+// HasFlags reports whether all of the flags are set on the webhook message.
+func (w *WebhookMessage) HasFlags(flags discordgo.MessageFlags) bool {
+	return w.flags&flags == flags
+}
+
+// SetSuppressEmbeds sets whether embeds generated from links in the content are hidden.
+func (w *WebhookMessage) SetSuppressEmbeds(suppress bool) *WebhookMessage {
+	return w.With(WithSuppressEmbeds(suppress))
+}
+
+// SetSuppressNotifications sets whether sending the message skips push and desktop notifications.
+func (w *WebhookMessage) SetSuppressNotifications(suppress bool) *WebhookMessage {
+	return w.With(WithSuppressNotifications(suppress))
+}
+
+// HasFlags reports whether all of the flags are set on the forum post's starter message.
+func (p *ForumPost) HasFlags(flags discordgo.MessageFlags) bool {
+	return p.flags&flags == flags
+}
+
+// SetSuppressEmbeds sets whether embeds generated from links in the content are hidden.
+func (p *ForumPost) SetSuppressEmbeds(suppress bool) *ForumPost {
+	return p.With(WithSuppressEmbeds(suppress))
+}
+
+// SetSuppressNotifications sets whether sending the post skips push and desktop notifications.
+func (p *ForumPost) SetSuppressNotifications(suppress bool) *ForumPost {
+	return p.With(WithSuppressNotifications(suppress))
+}
@@ -0,0 +1,152 @@
+package disgomsg
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestResponseSendEphemeralRepeated(t *testing.T) {
+	interaction := &discordgo.Interaction{ID: "interaction-1"}
+
+	// A response already built as ephemeral stays ephemeral.
+	rec := NewRecorder()
+	resp := NewResponse(WithContent("hello"), WithFlags(discordgo.MessageFlagsEphemeral))
+	if err := resp.SendEphemeral(rec, interaction); err != nil {
+		t.Fatalf("SendEphemeral returned error: %v", err)
+	}
+	req, _ := rec.Last()
+	if req.InteractionResponse.Data.Flags&discordgo.MessageFlagsEphemeral == 0 {
+		t.Error("Expected a response built as ephemeral to remain ephemeral")
+	}
+
+	// Retrying after a failure keeps the response ephemeral.
+	respondErr := errors.New("respond failed")
+	rec = NewRecorder()
+	rec.Errors = map[string]error{"InteractionRespond": respondErr}
+	resp = NewResponse(WithContent("hello"))
+	if err := resp.SendEphemeral(rec, interaction); !errors.Is(err, respondErr) {
+		t.Fatalf("Expected %v, got %v", respondErr, err)
+	}
+	rec.Errors = nil
+	if err := resp.SendEphemeral(rec, interaction); err != nil {
+		t.Fatalf("SendEphemeral returned error: %v", err)
+	}
+	for i, req := range rec.Requests() {
+		if req.InteractionResponse.Data.Flags&discordgo.MessageFlagsEphemeral == 0 {
+			t.Errorf("Expected attempt %d to be ephemeral", i+1)
+		}
+	}
+	if !resp.IsEphemeral() {
+		t.Error("Expected the response to report that it is ephemeral")
+	}
+}
+
+func TestFollowupSendEphemeralRepeated(t *testing.T) {
+	interaction := &discordgo.Interaction{ID: "interaction-1"}
+	rec := NewRecorder()
+	f := NewFollowup(WithContent("hello"))
+	for i := 0; i < 2; i++ {
+		if _, err := f.SendEphemeral(rec, interaction); err != nil {
+			t.Fatalf("SendEphemeral returned error: %v", err)
+		}
+		req, _ := rec.Last()
+		if req.WebhookParams.Flags != discordgo.MessageFlagsEphemeral {
+			t.Errorf("Expected send %d to be ephemeral, got flags %v", i+1, req.WebhookParams.Flags)
+		}
+	}
+}
+
+func TestFlagHelpers(t *testing.T) {
+	m := NewMessage().SetSuppressEmbeds(true).SetSuppressNotifications(true)
+	if !m.HasFlags(discordgo.MessageFlagsSuppressEmbeds | discordgo.MessageFlagsSuppressNotifications) {
+		t.Errorf("Expected both flags to be set, got %v", m.flags)
+	}
+	m.SetSuppressEmbeds(false)
+	if m.HasFlags(discordgo.MessageFlagsSuppressEmbeds) || !m.HasFlags(discordgo.MessageFlagsSuppressNotifications) {
+		t.Errorf("Expected only suppress notifications to remain, got %v", m.flags)
+	}
+
+	dm := NewDirectMessage(WithSuppressNotifications(true))
+	if !dm.HasFlags(discordgo.MessageFlagsSuppressNotifications) {
+		t.Errorf("Expected suppress notifications to be set, got %v", dm.flags)
+	}
+
+	r := NewResponse().SetEphemeral(true).SetEphemeral(true)
+	if r.flags != discordgo.MessageFlagsEphemeral {
+		t.Errorf("Expected setting ephemeral twice to leave it set, got %v", r.flags)
+	}
+	r.SetEphemeral(false)
+	if r.IsEphemeral() {
+		t.Error("Expected ephemeral to be cleared")
+	}
+
+	f := NewFollowup(WithEphemeral(true), WithSuppressEmbeds(true))
+	if !f.IsEphemeral() || !f.HasFlags(discordgo.MessageFlagsSuppressEmbeds) {
+		t.Errorf("Expected ephemeral and suppress embeds, got %v", f.flags)
+	}
+
+	// Only actual changes to the flags are sent in an edit.
+	rec := NewRecorder()
+	m = NewMessage(WithChannelID("channel-1"), WithMessageID("message-1")).SetSuppressEmbeds(false)
+	if m.changed&fieldFlags != 0 {
+		t.Error("Expected clearing an unset flag not to mark the flags as changed")
+	}
+	if err := m.SetSuppressEmbeds(true).Edit(rec); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	if req, _ := rec.Last(); req.MessageEdit.Flags != discordgo.MessageFlagsSuppressEmbeds {
+		t.Errorf("Expected the changed flags to be sent, got %v", req.MessageEdit.Flags)
+	}
+}
+
+func TestFlagValidation(t *testing.T) {
+	interaction := &discordgo.Interaction{ID: "interaction-1"}
+
+	msg := NewMessage(WithContent("hello"), WithEphemeral(true))
+	if !hasField(validationFields(msg.Validate()), "flags") {
+		t.Error("Expected the ephemeral flag to be rejected for a channel message")
+	}
+	dm := NewDirectMessage(WithContent("hello"), WithFlags(discordgo.MessageFlagsHasThread))
+	if !hasField(validationFields(dm.Validate()), "flags") {
+		t.Error("Expected a read-only flag to be rejected for a direct message")
+	}
+	if err := NewMessage(WithContent("hello"), WithSuppressNotifications(true)).Validate(); err != nil {
+		t.Errorf("Expected suppress notifications to be accepted, got %v", err)
+	}
+
+	if err := NewResponse(WithContent("hello"), WithEphemeral(true), WithSuppressEmbeds(true)).Validate(); err != nil {
+		t.Errorf("Expected ephemeral to be accepted for a response, got %v", err)
+	}
+	if err := NewFollowup(WithContent("hello"), WithEphemeral(true)).Validate(); err != nil {
+		t.Errorf("Expected ephemeral to be accepted for a follow-up, got %v", err)
+	}
+
+	// Editing a channel message only sends the suppress embeds flag.
+	rec := NewRecorder()
+	msg = NewMessage(WithContent("hello"), WithValidation(true), WithSuppressNotifications(true))
+	if _, err := msg.Send(rec, "channel-1"); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if err := msg.WithContent("updated").Edit(rec); err != nil {
+		t.Errorf("Expected an edit that leaves the flags untouched to pass, got %v", err)
+	}
+	if err := msg.SetSuppressEmbeds(true).Edit(rec); err != nil {
+		t.Fatalf("Expected send-only flags to be dropped from the edit, got %v", err)
+	}
+	if flags := last(rec).MessageEdit.Flags; flags != discordgo.MessageFlagsSuppressEmbeds {
+		t.Errorf("Expected only suppress embeds to be sent, got %b", flags)
+	}
+	requests := len(rec.Requests())
+	err := msg.WithFlags(discordgo.MessageFlagsEphemeral).Edit(rec)
+	if !hasField(validationFields(err), "flags") || len(rec.Requests()) != requests {
+		t.Errorf("Expected an edit adding the ephemeral flag to be rejected, got %v", err)
+	}
+
+	// The response is validated against the interaction flags when sent.
+	resp := NewResponse(WithContent("hello"), WithValidation(true), WithFlags(discordgo.MessageFlagsLoading))
+	if err := resp.Send(rec, interaction); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected ErrValidation, got %v", err)
+	}
+}
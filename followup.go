@@ -0,0 +1,127 @@
+package disgomsg
+
+import (
+	"context"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Followup is a Discord interaction follow-up message representation used for sending additional messages after
+// the initial response to an interaction.
+type Followup message
+
+// NewFollowup creates a new message instance with the provided options that may be sent as a follow-up message to
+// an interaction.
+func NewFollowup(opts ...Option) *Followup {
+	message := newMessage(opts...)
+	return (*Followup)(message)
+}
+
+// Send sends the follow-up message for the interaction using the provided Discord session.
+func (f *Followup) Send(s FollowupSender, i *discordgo.Interaction, options ...discordgo.RequestOption) (string, error) {
+	return f.SendContext(context.Background(), s, i, options...)
+}
+
+// SendContext is like Send, but the requests are bound to the context and any pending retries are abandoned once the
+// context is done.
+func (f *Followup) SendContext(ctx context.Context, s FollowupSender, i *discordgo.Interaction, options ...discordgo.RequestOption) (string, error) {
+	if f.validate {
+		if err := f.Validate(); err != nil {
+			return "", err
+		}
+	}
+	if i == nil {
+		return "", ErrMissingInteraction
+	}
+	params := (*message)(f).toWebhookParams()
+	f.interaction = i
+	var sent *discordgo.Message
+	err := (*message)(f).do(ctx, "send follow-up", options, func(options ...discordgo.RequestOption) (err error) {
+		sent, err = s.FollowupMessageCreate(f.interaction, true, params, options...)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	f.messageID = sent.ID
+	f.channelID = sent.ChannelID
+	(*message)(f).markSent()
+
+	return sent.ID, nil
+}
+
+// SendEphemeral sends the follow-up message as an ephemeral message using the provided Discord session.
+func (f *Followup) SendEphemeral(s FollowupSender, i *discordgo.Interaction, options ...discordgo.RequestOption) (string, error) {
+	return f.SendEphemeralContext(context.Background(), s, i, options...)
+}
+
+// SendEphemeralContext is like SendEphemeral, but the requests are bound to the context and any pending retries are
+// abandoned once the context is done.
+func (f *Followup) SendEphemeralContext(ctx context.Context, s FollowupSender, i *discordgo.Interaction, options ...discordgo.RequestOption) (string, error) {
+	f.SetEphemeral(true)
+	return f.SendContext(ctx, s, i, options...)
+}
+
+// Edit edits the existing follow-up message using the provided Discord session. Only the fields changed since the
+// message was last sent or edited are updated, unless WithFullEdit is set.
+func (f *Followup) Edit(s FollowupSender, options ...discordgo.RequestOption) error {
+	return f.EditContext(context.Background(), s, options...)
+}
+
+// EditContext is like Edit, but the requests are bound to the context and any pending retries are abandoned once the
+// context is done.
+func (f *Followup) EditContext(ctx context.Context, s FollowupSender, options ...discordgo.RequestOption) error {
+	if f.interaction == nil {
+		return ErrMissingInteraction
+	}
+	if f.messageID == "" {
+		return ErrMissingMessageID
+	}
+	if f.validate {
+		if err := (*message)(f).validateWebhookEdit(); err != nil {
+			return err
+		}
+	}
+	webhookEdit := (*message)(f).toWebhookEdit()
+	err := (*message)(f).do(ctx, "edit follow-up", options, func(options ...discordgo.RequestOption) error {
+		_, err := s.FollowupMessageEdit(f.interaction, f.messageID, webhookEdit, options...)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	(*message)(f).markSent()
+
+	return nil
+}
+
+// Delete deletes the follow-up message using the provided Discord session and clears the MessageID to indicate it
+// has been deleted.
+func (f *Followup) Delete(s FollowupSender, options ...discordgo.RequestOption) error {
+	return f.DeleteContext(context.Background(), s, options...)
+}
+
+// DeleteContext is like Delete, but the requests are bound to the context and any pending retries are abandoned once
+// the context is done.
+func (f *Followup) DeleteContext(ctx context.Context, s FollowupSender, options ...discordgo.RequestOption) error {
+	if f.interaction == nil {
+		return ErrMissingInteraction
+	}
+	if f.messageID == "" {
+		return ErrMissingMessageID
+	}
+	err := (*message)(f).do(ctx, "delete follow-up", options, func(options ...discordgo.RequestOption) error {
+		return s.FollowupMessageDelete(f.interaction, f.messageID, options...)
+	})
+	if err != nil {
+		return err
+	}
+	f.messageID = "" // Clear the ID after deletion
+	return nil
+}
+
+// Validate checks the follow-up message against Discord's documented limits. All violations are returned as a
+// single joined error, with each violation reported as a *ValidationError.
+func (f *Followup) Validate() error {
+	return (*message)(f).validateMessage(true, interactionFlags)
+}
@@ -0,0 +1,102 @@
+package disgomsg
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestNewFollowup(t *testing.T) {
+	followup := NewFollowup(WithContent("hello"))
+	if followup == nil {
+		t.Fatal("Expected non-nil follow-up")
+	}
+	if followup.content != "hello" {
+		t.Errorf("Expected content %q, got %q", "hello", followup.content)
+	}
+}
+
+func TestFollowupWithMethods(t *testing.T) {
+	interaction := &discordgo.Interaction{ID: "interaction-1"}
+	embeds := []*discordgo.MessageEmbed{{Title: "Test Embed"}}
+	components := []discordgo.MessageComponent{discordgo.Button{Label: "Test Button"}}
+	files := []*discordgo.File{{Name: "file.txt"}}
+
+	followup := NewFollowup().
+		WithInteraction(interaction).
+		WithMessageID("message-1").
+		WithContent("content").
+		WithEmbeds(embeds).
+		WithComponents(components).
+		WithFiles(files)
+	if followup.interaction != interaction || followup.messageID != "message-1" || followup.content != "content" {
+		t.Errorf("Unexpected follow-up %+v", followup)
+	}
+	if len(followup.embeds) != 1 || len(followup.components) != 1 || len(followup.files) != 1 {
+		t.Errorf("Expected embeds, components and files to be set")
+	}
+}
+
+func TestFollowupSendEditDelete(t *testing.T) {
+	rec := NewRecorder()
+	interaction := &discordgo.Interaction{ID: "interaction-1", ChannelID: "channel-1"}
+	files := []*discordgo.File{{Name: "report.txt"}}
+	followup := NewFollowup(WithContent("hello"), WithFiles(files))
+
+	messageID, err := followup.SendEphemeral(rec, interaction)
+	if err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	req, _ := rec.Last()
+	if req.Method != "FollowupMessageCreate" || req.Interaction != interaction {
+		t.Errorf("Unexpected send request %+v", req)
+	}
+	if req.WebhookParams.Content != "hello" || len(req.WebhookParams.Files) != 1 {
+		t.Errorf("Unexpected follow-up params %+v", req.WebhookParams)
+	}
+	if req.WebhookParams.Flags&discordgo.MessageFlagsEphemeral == 0 {
+		t.Error("Expected follow-up to be ephemeral")
+	}
+	if followup.messageID != messageID || followup.channelID != "channel-1" {
+		t.Errorf("Expected message and channel IDs to be recorded, got %q and %q", followup.messageID, followup.channelID)
+	}
+
+	if err := followup.WithContent("updated").Edit(rec); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	req, _ = rec.Last()
+	if req.Method != "FollowupMessageEdit" || req.MessageID != messageID || *req.WebhookEdit.Content != "updated" {
+		t.Errorf("Unexpected edit request %+v", req)
+	}
+
+	if err := followup.Delete(rec); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	req, _ = rec.Last()
+	if req.Method != "FollowupMessageDelete" || req.MessageID != messageID {
+		t.Errorf("Unexpected delete request %+v", req)
+	}
+	if followup.messageID != "" {
+		t.Errorf("Expected messageID to be cleared, got %q", followup.messageID)
+	}
+	if err := followup.Edit(rec); !errors.Is(err, ErrMissingMessageID) {
+		t.Errorf("Expected ErrMissingMessageID, got %v", err)
+	}
+}
+
+func TestFollowupMissingInteraction(t *testing.T) {
+	rec := NewRecorder()
+	if _, err := NewFollowup(WithContent("hello")).Send(rec, nil); !errors.Is(err, ErrMissingInteraction) {
+		t.Errorf("Expected ErrMissingInteraction, got %v", err)
+	}
+	if err := NewFollowup().WithMessageID("message-1").Edit(rec); !errors.Is(err, ErrMissingInteraction) {
+		t.Errorf("Expected ErrMissingInteraction, got %v", err)
+	}
+	if err := NewFollowup().WithMessageID("message-1").Delete(rec); !errors.Is(err, ErrMissingInteraction) {
+		t.Errorf("Expected ErrMissingInteraction, got %v", err)
+	}
+	if len(rec.Requests()) != 0 {
+		t.Errorf("Expected no requests, got %d", len(rec.Requests()))
+	}
+}
@@ -0,0 +1,159 @@
+package disgomsg
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// ForumPost is a Discord forum post representation used for creating a thread in a forum or media channel along
+// with its starter message. The post's title is set with WithTitle and its tags with WithTagIDs or WithTagNames;
+// all other options apply to the starter message.
+type ForumPost message
+
+// NewForumPost creates a new forum post instance with the provided options.
+func NewForumPost(opts ...Option) *ForumPost {
+	message := newMessage(opts...)
+	return (*ForumPost)(message)
+}
+
+// Send creates the post in the forum channel using the provided Discord session. Any tag names are first resolved
+// to tag IDs using the channel's available tags, failing with ErrUnknownForumTag if a name does not match any tag.
+// The IDs of the new thread and of its starter message are returned, and retained so the starter message may later
+// be edited or deleted.
+func (p *ForumPost) Send(s ForumSender, channelID string, options ...discordgo.RequestOption) (threadID, messageID string, err error) {
+	return p.SendContext(context.Background(), s, channelID, options...)
+}
+
+// SendContext is like Send, but the requests are bound to the context and any pending retries are abandoned once the
+// context is done.
+func (p *ForumPost) SendContext(ctx context.Context, s ForumSender, channelID string, options ...discordgo.RequestOption) (threadID, messageID string, err error) {
+	if p.validate {
+		if err := p.Validate(); err != nil {
+			return "", "", err
+		}
+	}
+	if channelID == "" {
+		return "", "", ErrMissingChannelID
+	}
+	if p.title == "" {
+		return "", "", ErrMissingThreadName
+	}
+	tagIDs, err := p.resolveTags(ctx, s, channelID, options)
+	if err != nil {
+		return "", "", err
+	}
+	threadData := &discordgo.ThreadStart{Name: p.title, AppliedTags: tagIDs}
+	messageData := (*message)(p).toMessageSend()
+	var thread *discordgo.Channel
+	err = (*message)(p).do(ctx, "create forum post", options, func(options ...discordgo.RequestOption) (err error) {
+		thread, err = s.ForumThreadStartComplex(channelID, threadData, messageData, options...)
+		return err
+	})
+	if err != nil {
+		return "", "", err
+	}
+	// The starter message of a forum post shares the ID of its thread.
+	p.threadID = thread.ID
+	p.channelID = thread.ID
+	p.messageID = thread.ID
+	(*message)(p).markSent()
+
+	return p.threadID, p.messageID, nil
+}
+
+// Edit edits the starter message of the post using the provided Discord session. Only the fields changed since the
+// post was last sent or edited are updated, unless WithFullEdit is set. The title and tags are not changed.
+func (p *ForumPost) Edit(s Sender, options ...discordgo.RequestOption) error {
+	return p.EditContext(context.Background(), s, options...)
+}
+
+// EditContext is like Edit, but the requests are bound to the context and any pending retries are abandoned once the
+// context is done.
+func (p *ForumPost) EditContext(ctx context.Context, s Sender, options ...discordgo.RequestOption) error {
+	return (*message)(p).editInChannel(ctx, s, options...)
+}
+
+// Delete deletes the starter message of the post using the provided Discord session and clears the MessageID to
+// indicate it has been deleted. The thread itself is not deleted.
+func (p *ForumPost) Delete(s Sender, options ...discordgo.RequestOption) error {
+	return p.DeleteContext(context.Background(), s, options...)
+}
+
+// DeleteContext is like Delete, but the requests are bound to the context and any pending retries are abandoned once
+// the context is done.
+func (p *ForumPost) DeleteContext(ctx context.Context, s Sender, options ...discordgo.RequestOption) error {
+	return (*message)(p).deleteFromChannel(ctx, s, options...)
+}
+
+// ThreadID returns the ID of the thread created for the post, or an empty string if it has not been sent.
+func (p *ForumPost) ThreadID() string {
+	return p.threadID
+}
+
+// MessageID returns the ID of the post's starter message, or an empty string if it has not been sent or has been
+// deleted.
+func (p *ForumPost) MessageID() string {
+	return p.messageID
+}
+
+// Validate checks the forum post against Discord's documented limits. All violations are returned as a single joined
+// error, with each violation reported as a *ValidationError.
+func (p *ForumPost) Validate() error {
+	m := (*message)(p)
+	v := &validator{}
+	m.checkMessage(v, true, channelSendFlags)
+	if m.title == "" {
+		v.add("title", "forum post must have a title")
+	}
+	v.maxLength("title", m.title, MaxThreadNameLength)
+	v.maxCount("tags", len(m.tagIDs)+len(m.tagNames), MaxForumTags)
+	return v.err()
+}
+
+// resolveTags returns the IDs of the tags to apply to the post, resolving any tag names against the forum channel's
+// available tags. Duplicate tags are only included once.
+func (p *ForumPost) resolveTags(ctx context.Context, s ForumSender, channelID string, options []discordgo.RequestOption) ([]string, error) {
+	tagIDs := make([]string, 0, len(p.tagIDs)+len(p.tagNames))
+	seen := make(map[string]bool, cap(tagIDs))
+	add := func(id string) {
+		if !seen[id] {
+			seen[id] = true
+			tagIDs = append(tagIDs, id)
+		}
+	}
+	for _, id := range p.tagIDs {
+		add(id)
+	}
+	if len(p.tagNames) == 0 {
+		return tagIDs, nil
+	}
+
+	var channel *discordgo.Channel
+	err := (*message)(p).do(ctx, "fetch forum channel", options, func(options ...discordgo.RequestOption) (err error) {
+		channel, err = s.Channel(channelID, options...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	available := make(map[string]string, len(channel.AvailableTags))
+	for _, tag := range channel.AvailableTags {
+		available[strings.ToLower(tag.Name)] = tag.ID
+	}
+	var unknown []string
+	for _, name := range p.tagNames {
+		id, ok := available[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			unknown = append(unknown, fmt.Sprintf("%q", name))
+			continue
+		}
+		add(id)
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownForumTag, strings.Join(unknown, ", "))
+	}
+	return tagIDs, nil
+}
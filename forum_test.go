@@ -0,0 +1,138 @@
+package disgomsg
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// forumRecorder returns a recorder holding a forum channel with a few available tags.
+func forumRecorder() *Recorder {
+	rec := NewRecorder()
+	rec.Channels = map[string]*discordgo.Channel{
+		"forum-1": {
+			ID:   "forum-1",
+			Type: discordgo.ChannelTypeGuildForum,
+			AvailableTags: []discordgo.ForumTag{
+				{ID: "tag-bug", Name: "Bug"},
+				{ID: "tag-feature", Name: "Feature Request"},
+				{ID: "tag-docs", Name: "Docs"},
+			},
+		},
+	}
+	return rec
+}
+
+func TestForumPostSend(t *testing.T) {
+	rec := forumRecorder()
+	post := NewForumPost(WithTitle("Crash on startup"), WithContent("Steps to reproduce"), WithTagIDs([]string{"tag-bug"}))
+
+	threadID, messageID, err := post.Send(rec, "forum-1")
+	if err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	requests := rec.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("Expected a single request when no tag names are used, got %d", len(requests))
+	}
+	req := requests[0]
+	if req.Method != "ForumThreadStartComplex" || req.ChannelID != "forum-1" {
+		t.Errorf("Expected ForumThreadStartComplex in forum-1, got %s in %q", req.Method, req.ChannelID)
+	}
+	if req.ThreadStart.Name != "Crash on startup" || !reflect.DeepEqual(req.ThreadStart.AppliedTags, []string{"tag-bug"}) {
+		t.Errorf("Expected title and tags in thread data, got %+v", req.ThreadStart)
+	}
+	if req.MessageSend.Content != "Steps to reproduce" {
+		t.Errorf("Expected starter message content, got %q", req.MessageSend.Content)
+	}
+	if threadID == "" || messageID != threadID {
+		t.Errorf("Expected starter message ID to match thread ID, got %q and %q", threadID, messageID)
+	}
+	if post.ThreadID() != threadID || post.MessageID() != messageID {
+		t.Errorf("Expected IDs to be retained, got %q and %q", post.ThreadID(), post.MessageID())
+	}
+
+	if err := post.WithContent("Fixed in 1.2").Edit(rec); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	req = last(rec)
+	if req.MessageEdit.Channel != threadID || req.MessageEdit.ID != messageID {
+		t.Errorf("Expected edit of the starter message in the thread, got %+v", req.MessageEdit)
+	}
+}
+
+func TestForumPostTagNames(t *testing.T) {
+	rec := forumRecorder()
+	post := NewForumPost(
+		WithTitle("Add dark mode"),
+		WithContent("Please"),
+		WithTagIDs([]string{"tag-docs"}),
+		WithTagNames([]string{"feature request", " Docs "}),
+	)
+	if _, _, err := post.Send(rec, "forum-1"); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	requests := rec.Requests()
+	if len(requests) != 2 || requests[0].Method != "Channel" || requests[0].ChannelID != "forum-1" {
+		t.Fatalf("Expected the forum channel to be fetched before posting, got %+v", requests)
+	}
+	want := []string{"tag-docs", "tag-feature"}
+	if got := requests[1].ThreadStart.AppliedTags; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected applied tags %v, got %v", want, got)
+	}
+}
+
+func TestForumPostUnknownTag(t *testing.T) {
+	rec := forumRecorder()
+	post := NewForumPost(WithTitle("Question"), WithContent("How?"), WithTagNames([]string{"Bug", "Question"}))
+	_, _, err := post.Send(rec, "forum-1")
+	if !errors.Is(err, ErrUnknownForumTag) {
+		t.Fatalf("Expected ErrUnknownForumTag, got %v", err)
+	}
+	if !strings.Contains(err.Error(), `"Question"`) {
+		t.Errorf("Expected the unknown tag to be named, got %v", err)
+	}
+	if req := last(rec); req.Method != "Channel" {
+		t.Errorf("Expected no post to be created, got %s", req.Method)
+	}
+
+	_, _, err = post.Send(rec, "forum-2")
+	if !errors.Is(err, ErrUnknownChannel) {
+		t.Errorf("Expected ErrUnknownChannel for a missing channel, got %v", err)
+	}
+}
+
+func TestForumPostErrors(t *testing.T) {
+	rec := forumRecorder()
+	if _, _, err := NewForumPost(WithTitle("Title")).Send(rec, ""); !errors.Is(err, ErrMissingChannelID) {
+		t.Errorf("Expected ErrMissingChannelID, got %v", err)
+	}
+	if _, _, err := NewForumPost(WithContent("hello")).Send(rec, "forum-1"); !errors.Is(err, ErrMissingThreadName) {
+		t.Errorf("Expected ErrMissingThreadName, got %v", err)
+	}
+	if len(rec.Requests()) != 0 {
+		t.Errorf("Expected no requests, got %d", len(rec.Requests()))
+	}
+}
+
+func TestForumPostValidate(t *testing.T) {
+	post := NewForumPost(
+		WithContent("hello"),
+		WithTitle(strings.Repeat("t", MaxThreadNameLength+1)),
+		WithTagIDs([]string{"1", "2", "3"}),
+		WithTagNames([]string{"4", "5", "6"}),
+	)
+	fields := validationFields(post.Validate())
+	if !hasField(fields, "title") || !hasField(fields, "tags") {
+		t.Errorf("Expected title and tags violations, got %v", fields)
+	}
+	if fields := validationFields(NewForumPost(WithContent("hello")).Validate()); !hasField(fields, "title") {
+		t.Errorf("Expected missing title violation, got %v", fields)
+	}
+	if err := NewForumPost(WithTitle("Title"), WithContent("hello")).Validate(); err != nil {
+		t.Errorf("Expected valid post, got %v", err)
+	}
+}
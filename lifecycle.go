@@ -0,0 +1,153 @@
+package disgomsg
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// InteractionTokenLifetime is how long an interaction token remains valid for editing or deleting the response.
+const InteractionTokenLifetime = 15 * time.Minute
+
+// now returns the current time. It is a variable so tests can control the passage of time.
+var now = time.Now
+
+// InteractionState is the stage of the lifecycle of an interaction response.
+type InteractionState int
+
+const (
+	// InteractionStateNew indicates the interaction has not been responded to.
+	InteractionStateNew InteractionState = iota
+	// InteractionStateDeferred indicates the interaction has been acknowledged, but no reply has been sent.
+	InteractionStateDeferred
+	// InteractionStateResponded indicates a reply has been sent to the interaction.
+	InteractionStateResponded
+	// InteractionStateDeleted indicates the reply to the interaction has been deleted.
+	InteractionStateDeleted
+	// InteractionStateExpired indicates the interaction token has expired, so the reply can no longer be changed.
+	InteractionStateExpired
+)
+
+// String returns the name of the state.
+func (s InteractionState) String() string {
+	switch s {
+	case InteractionStateNew:
+		return "new"
+	case InteractionStateDeferred:
+		return "deferred"
+	case InteractionStateResponded:
+		return "responded"
+	case InteractionStateDeleted:
+		return "deleted"
+	case InteractionStateExpired:
+		return "expired"
+	default:
+		return "unknown"
+	}
+}
+
+// State returns the current state of the response to its interaction.
+func (r *Response) State() InteractionState {
+	if r.state != InteractionStateDeleted && r.expired() {
+		return InteractionStateExpired
+	}
+	return r.state
+}
+
+// DeferredAt returns when the interaction was deferred, or the zero time if it has not been deferred.
+func (r *Response) DeferredAt() time.Time {
+	return r.deferredAt
+}
+
+// RespondedAt returns when the reply was sent, or the zero time if it has not been sent.
+func (r *Response) RespondedAt() time.Time {
+	return r.respondedAt
+}
+
+// DeletedAt returns when the reply was deleted, or the zero time if it has not been deleted.
+func (r *Response) DeletedAt() time.Time {
+	return r.deletedAt
+}
+
+// ExpiresAt returns when the interaction token expires, after which the reply can no longer be changed. The zero
+// time is returned if the expiry is not yet known.
+func (r *Response) ExpiresAt() time.Time {
+	if r.interaction != nil {
+		if created, ok := snowflakeTime(r.interaction.ID); ok {
+			return created.Add(InteractionTokenLifetime)
+		}
+	}
+	acknowledged := r.deferredAt
+	if acknowledged.IsZero() {
+		acknowledged = r.respondedAt
+	}
+	if acknowledged.IsZero() {
+		return time.Time{}
+	}
+	return acknowledged.Add(InteractionTokenLifetime)
+}
+
+// snowflakeTime returns when the object with the snowflake ID was created. An ID without timestamp bits is not a
+// snowflake Discord issued, so it is rejected rather than treated as created at the Discord epoch.
+func snowflakeTime(id string) (time.Time, bool) {
+	n, err := strconv.ParseUint(id, 10, 64)
+	if err != nil || n>>22 == 0 {
+		return time.Time{}, false
+	}
+	created, err := discordgo.SnowflakeTimestamp(id)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return created, true
+}
+
+// expired reports whether the interaction token has expired.
+func (r *Response) expired() bool {
+	expiresAt := r.ExpiresAt()
+	return !expiresAt.IsZero() && !now().Before(expiresAt)
+}
+
+// bindInteraction binds the response to the interaction. Binding to a different interaction resets the state.
+func (r *Response) bindInteraction(i *discordgo.Interaction) {
+	if i == nil || i == r.interaction {
+		return
+	}
+	r.interaction = i
+	r.state = InteractionStateNew
+	r.deferredAt = time.Time{}
+	r.respondedAt = time.Time{}
+	r.deletedAt = time.Time{}
+}
+
+// checkCanRespond returns an error if the interaction cannot be responded to, or the deferred reply sent.
+func (r *Response) checkCanRespond() error {
+	if r.interaction == nil {
+		return ErrMissingInteraction
+	}
+	switch r.State() {
+	case InteractionStateResponded:
+		return ErrAlreadyResponded
+	case InteractionStateDeleted:
+		return ErrResponseDeleted
+	case InteractionStateExpired:
+		return ErrInteractionExpired
+	}
+	return nil
+}
+
+// checkCanModify returns an error if the reply to the interaction cannot be edited or deleted.
+func (r *Response) checkCanModify() error {
+	if r.interaction == nil {
+		return ErrMissingInteraction
+	}
+	switch r.State() {
+	case InteractionStateNew:
+		return ErrNotResponded
+	case InteractionStateDeleted:
+		return ErrResponseDeleted
+	case InteractionStateExpired:
+		return ErrInteractionExpired
+	}
+	return nil
+}
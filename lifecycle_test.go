@@ -0,0 +1,172 @@
+package disgomsg
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// setNow sets the current time used by the package for the duration of the test.
+func setNow(t *testing.T, current time.Time) {
+	t.Helper()
+	previous := now
+	now = func() time.Time { return current }
+	t.Cleanup(func() { now = previous })
+}
+
+// snowflake returns a snowflake ID for the given time.
+func snowflake(ts time.Time) string {
+	ms := ts.UnixMilli() - 1420070400000
+	return strconv.FormatInt(ms<<22, 10)
+}
+
+func TestInteractionStateString(t *testing.T) {
+	tests := map[InteractionState]string{
+		InteractionStateNew:       "new",
+		InteractionStateDeferred:  "deferred",
+		InteractionStateResponded: "responded",
+		InteractionStateDeleted:   "deleted",
+		InteractionStateExpired:   "expired",
+		InteractionState(99):      "unknown",
+	}
+	for state, expected := range tests {
+		if state.String() != expected {
+			t.Errorf("Expected %q, got %q", expected, state.String())
+		}
+	}
+}
+
+func TestResponseLifecycle(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	setNow(t, start)
+	rec := NewRecorder()
+	interaction := &discordgo.Interaction{ID: "interaction-1"}
+	resp := NewResponse(WithContent("hello"))
+
+	if resp.State() != InteractionStateNew {
+		t.Errorf("Expected state new, got %v", resp.State())
+	}
+	if err := resp.Edit(rec); !errors.Is(err, ErrMissingInteraction) {
+		t.Errorf("Expected ErrMissingInteraction, got %v", err)
+	}
+	resp.WithInteraction(interaction)
+	if err := resp.Edit(rec); !errors.Is(err, ErrNotResponded) {
+		t.Errorf("Expected ErrNotResponded, got %v", err)
+	}
+	if err := resp.Delete(rec); !errors.Is(err, ErrNotResponded) {
+		t.Errorf("Expected ErrNotResponded, got %v", err)
+	}
+
+	if err := resp.Defer(rec, interaction, false); err != nil {
+		t.Fatalf("Defer returned error: %v", err)
+	}
+	if resp.State() != InteractionStateDeferred || !resp.DeferredAt().Equal(start) {
+		t.Errorf("Expected deferred state at %v, got %v at %v", start, resp.State(), resp.DeferredAt())
+	}
+	if err := resp.Defer(rec, interaction, false); !errors.Is(err, ErrAlreadyResponded) {
+		t.Errorf("Expected ErrAlreadyResponded, got %v", err)
+	}
+
+	if err := resp.Send(rec, interaction); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if resp.State() != InteractionStateResponded || !resp.RespondedAt().Equal(start) {
+		t.Errorf("Expected responded state, got %v", resp.State())
+	}
+	if err := resp.Send(rec, interaction); !errors.Is(err, ErrAlreadyResponded) {
+		t.Errorf("Expected ErrAlreadyResponded, got %v", err)
+	}
+	if err := resp.Edit(rec); err != nil {
+		t.Errorf("Edit returned error: %v", err)
+	}
+
+	if err := resp.Delete(rec); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	if resp.State() != InteractionStateDeleted || !resp.DeletedAt().Equal(start) {
+		t.Errorf("Expected deleted state, got %v", resp.State())
+	}
+	if err := resp.Edit(rec); !errors.Is(err, ErrResponseDeleted) {
+		t.Errorf("Expected ErrResponseDeleted, got %v", err)
+	}
+	if err := resp.Send(rec, interaction); !errors.Is(err, ErrResponseDeleted) {
+		t.Errorf("Expected ErrResponseDeleted, got %v", err)
+	}
+
+	// Binding to a new interaction starts a new lifecycle
+	if err := resp.Send(rec, &discordgo.Interaction{ID: "interaction-2"}); err != nil {
+		t.Errorf("Expected Send to a new interaction to succeed, got %v", err)
+	}
+}
+
+func TestResponseFailedSendKeepsState(t *testing.T) {
+	rec := NewRecorder()
+	rec.Errors = map[string]error{"InteractionRespond": errors.New("unknown interaction")}
+	resp := NewResponse(WithContent("hello"))
+	if err := resp.Send(rec, &discordgo.Interaction{}); err == nil {
+		t.Fatal("Expected Send to fail")
+	}
+	if resp.State() != InteractionStateNew || !resp.RespondedAt().IsZero() {
+		t.Errorf("Expected state to remain new, got %v", resp.State())
+	}
+}
+
+func TestResponseExpiry(t *testing.T) {
+	created := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	setNow(t, created.Add(time.Second))
+	rec := NewRecorder()
+	interaction := &discordgo.Interaction{ID: snowflake(created)}
+	resp := NewResponse(WithContent("hello"))
+
+	if err := resp.Send(rec, interaction); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if expected := created.Add(InteractionTokenLifetime); !resp.ExpiresAt().Equal(expected) {
+		t.Errorf("Expected expiry %v, got %v", expected, resp.ExpiresAt())
+	}
+
+	setNow(t, created.Add(InteractionTokenLifetime))
+	if resp.State() != InteractionStateExpired {
+		t.Errorf("Expected expired state, got %v", resp.State())
+	}
+	if err := resp.Edit(rec); !errors.Is(err, ErrInteractionExpired) {
+		t.Errorf("Expected ErrInteractionExpired, got %v", err)
+	}
+	if err := resp.Delete(rec); !errors.Is(err, ErrInteractionExpired) {
+		t.Errorf("Expected ErrInteractionExpired, got %v", err)
+	}
+}
+
+func TestResponseExpiryWithoutSnowflake(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	setNow(t, start)
+	resp := NewResponse()
+	if !resp.ExpiresAt().IsZero() {
+		t.Errorf("Expected unknown expiry, got %v", resp.ExpiresAt())
+	}
+	if err := resp.Defer(NewRecorder(), &discordgo.Interaction{ID: "not-a-snowflake"}, false); err != nil {
+		t.Fatalf("Defer returned error: %v", err)
+	}
+	if expected := start.Add(InteractionTokenLifetime); !resp.ExpiresAt().Equal(expected) {
+		t.Errorf("Expected expiry %v, got %v", expected, resp.ExpiresAt())
+	}
+}
+
+func TestResponseExpiryNumericID(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	setNow(t, start)
+	rec := NewRecorder()
+	resp := NewResponse(WithContent("hello"))
+	if err := resp.Send(rec, &discordgo.Interaction{ID: "123456"}); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if expected := start.Add(InteractionTokenLifetime); !resp.ExpiresAt().Equal(expected) {
+		t.Errorf("Expected expiry %v, got %v", expected, resp.ExpiresAt())
+	}
+	if err := resp.WithContent("updated").Edit(rec); err != nil {
+		t.Errorf("Expected edit to succeed, got %v", err)
+	}
+}
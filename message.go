@@ -1,26 +1,71 @@
 package disgomsg
 
-import "github.com/bwmarrin/discordgo"
+import (
+	"time"
 
-// message is the common struct for all direct messages, channel messages and responses
+	"github.com/bwmarrin/discordgo"
+)
+
+// message is the common struct for all direct messages, channel messages, webhook messages, forum posts and responses
 type message struct {
 	allowedMentions *discordgo.MessageAllowedMentions
 	attachments     []*discordgo.MessageAttachment
+	avatarURL       string // Webhook only.
 	channelID       string
+	changed         fieldMask                                   // Fields changed since the message was last sent or edited.
 	choices         []*discordgo.ApplicationCommandOptionChoice // Autocomplete interaction only.
 	components      []discordgo.MessageComponent
 	content         string
-	customID        string // Modal interaction only.
+	customID        string    // Modal interaction only.
+	deferredAt      time.Time // Interaction only.
+	deletedAt       time.Time // Interaction only.
 	embeds          []*discordgo.MessageEmbed
 	files           []*discordgo.File
-	flags           discordgo.MessageFlags // Only MessageFlagsSuppressEmbeds and MessageFlagsEphemeral are valid.
+	flags           discordgo.MessageFlags // Valid flags depend on the request; see channelSendFlags and related constants.
+	fullEdit        bool                   // Include all fields when editing, not just those that changed.
 	interaction     *discordgo.Interaction
 	messageID       string
 	reference       *discordgo.MessageReference
+	respondedAt     time.Time // Interaction only.
+	retryPolicy     *RetryPolicy
 	responseType    *discordgo.InteractionResponseType
+	state           InteractionState // Interaction only.
 	stickerIDs      []string
+	tagIDs          []string // Forum post only.
+	tagNames        []string // Forum post only.
+	threadID        string   // Channel and webhook messages only.
+	threadName      string   // Webhook only.
 	title           string
 	tts             bool
+	username        string // Webhook only.
+	validate        bool   // Validate the message before it is sent.
+	webhookID       string
+	webhookToken    string
+}
+
+// fieldMask identifies message fields that have changed since the message was last sent, edited or hydrated from
+// an existing Discord message. Only these fields are included when a message is edited, so any others are left
+// untouched, including any changes made to them by other processes.
+type fieldMask uint8
+
+const (
+	fieldContent fieldMask = 1 << iota
+	fieldEmbeds
+	fieldComponents
+	fieldAttachments
+	fieldFiles
+	fieldFlags
+
+	// fullEditFields are the fields included in a full edit. Attachments and files are excluded, as resending
+	// them would remove or duplicate the files already on the message.
+	fullEditFields = fieldContent | fieldEmbeds | fieldComponents | fieldFlags
+	allFields      = fullEditFields | fieldAttachments | fieldFiles
+)
+
+// markSent records that the message has been sent or edited, so that later edits only include fields changed
+// after this point.
+func (m *message) markSent() {
+	m.changed = 0
 }
 
 // newMessage creates a new message with the given options
@@ -35,6 +80,27 @@ func newMessage(opts ...Option) *message {
 // Option is a function that modifies a message.
 type Option func(*message)
 
+// ClearAttachments removes all existing attachments when the message is edited. Files added with WithFiles are
+// still uploaded.
+func ClearAttachments() Option {
+	return WithAttachments([]*discordgo.MessageAttachment{})
+}
+
+// ClearComponents removes all components when the message is edited.
+func ClearComponents() Option {
+	return WithComponents([]discordgo.MessageComponent{})
+}
+
+// ClearContent removes the content when the message is edited.
+func ClearContent() Option {
+	return WithContent("")
+}
+
+// ClearEmbeds removes all embeds when the message is edited.
+func ClearEmbeds() Option {
+	return WithEmbeds([]*discordgo.MessageEmbed{})
+}
+
 // WithAllowedMentions sets the allowed mentions for the message.
 func WithAllowedMentions(allowedMentions *discordgo.MessageAllowedMentions) Option {
 	return func(f *message) {
@@ -46,6 +112,14 @@ func WithAllowedMentions(allowedMentions *discordgo.MessageAllowedMentions) Opti
 func WithAttachments(attachments []*discordgo.MessageAttachment) Option {
 	return func(f *message) {
 		f.attachments = attachments
+		f.changed |= fieldAttachments
+	}
+}
+
+// WithAvatarURL sets the URL of the avatar shown for a webhook message, overriding the webhook's default avatar.
+func WithAvatarURL(avatarURL string) Option {
+	return func(f *message) {
+		f.avatarURL = avatarURL
 	}
 }
 
@@ -67,6 +141,7 @@ func WithChoices(choices []*discordgo.ApplicationCommandOptionChoice) Option {
 func WithComponents(components []discordgo.MessageComponent) Option {
 	return func(f *message) {
 		f.components = components
+		f.changed |= fieldComponents
 	}
 }
 
@@ -74,6 +149,7 @@ func WithComponents(components []discordgo.MessageComponent) Option {
 func WithContent(content string) Option {
 	return func(f *message) {
 		f.content = content
+		f.changed |= fieldContent
 	}
 }
 
@@ -88,6 +164,7 @@ func WithCustomID(customID string) Option {
 func WithEmbeds(embeds []*discordgo.MessageEmbed) Option {
 	return func(f *message) {
 		f.embeds = embeds
+		f.changed |= fieldEmbeds
 	}
 }
 
@@ -95,6 +172,7 @@ func WithEmbeds(embeds []*discordgo.MessageEmbed) Option {
 func WithFiles(files []*discordgo.File) Option {
 	return func(f *message) {
 		f.files = files
+		f.changed |= fieldFiles
 	}
 }
 
@@ -102,6 +180,15 @@ func WithFiles(files []*discordgo.File) Option {
 func WithFlags(flags discordgo.MessageFlags) Option {
 	return func(f *message) {
 		f.flags = flags
+		f.changed |= fieldFlags
+	}
+}
+
+// WithFullEdit sets whether edits include the content, embeds, components and flags even if they have not changed
+// since the message was last sent or edited. Attachments and files are still only included if they have changed.
+func WithFullEdit(fullEdit bool) Option {
+	return func(f *message) {
+		f.fullEdit = fullEdit
 	}
 }
 
@@ -140,6 +227,36 @@ func WithStickerIDs(stickerIDs []string) Option {
 	}
 }
 
+// WithTagIDs sets the IDs of the tags applied to a forum post.
+func WithTagIDs(tagIDs []string) Option {
+	return func(f *message) {
+		f.tagIDs = tagIDs
+	}
+}
+
+// WithTagNames sets the names of the tags applied to a forum post. The names are resolved to tag IDs, ignoring
+// case, using the forum channel's available tags when the post is sent.
+func WithTagNames(tagNames []string) Option {
+	return func(f *message) {
+		f.tagNames = tagNames
+	}
+}
+
+// WithThreadID sets the thread a channel or webhook message is sent to, in place of the channel or the webhook's
+// channel.
+func WithThreadID(threadID string) Option {
+	return func(f *message) {
+		f.threadID = threadID
+	}
+}
+
+// WithThreadName sets the name of the thread created for a webhook message sent to a forum or media channel.
+func WithThreadName(threadName string) Option {
+	return func(f *message) {
+		f.threadName = threadName
+	}
+}
+
 // WithTitle sets the title for the message.
 func WithTitle(title string) Option {
 	return func(f *message) {
@@ -153,3 +270,25 @@ func WithTTS(tts bool) Option {
 		f.tts = tts
 	}
 }
+
+// WithUsername sets the username shown for a webhook message, overriding the webhook's default name.
+func WithUsername(username string) Option {
+	return func(f *message) {
+		f.username = username
+	}
+}
+
+// WithValidation sets whether the message is validated against Discord's limits before it is sent or edited.
+func WithValidation(validate bool) Option {
+	return func(f *message) {
+		f.validate = validate
+	}
+}
+
+// WithWebhook sets the ID and token of the webhook used to send, edit and delete a webhook message.
+func WithWebhook(webhookID, token string) Option {
+	return func(f *message) {
+		f.webhookID = webhookID
+		f.webhookToken = token
+	}
+}
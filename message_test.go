@@ -237,3 +237,10 @@ func TestWithTTS(t *testing.T) {
 		t.Errorf("Expected tts to be true, got false")
 	}
 }
+
+func TestWithValidation(t *testing.T) {
+	msg := newMessage(WithValidation(true))
+	if !msg.validate {
+		t.Error("Expected validate to be true")
+	}
+}
@@ -0,0 +1,114 @@
+package disgomsg
+
+import (
+	"context"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// TextInputBuilder is a fluent builder for the text inputs shown in a modal.
+type TextInputBuilder struct {
+	input discordgo.TextInput
+}
+
+// NewShortInput creates a new text input builder for a single-line text input.
+func NewShortInput(customID, label string) *TextInputBuilder {
+	return &TextInputBuilder{input: discordgo.TextInput{CustomID: customID, Label: label, Style: discordgo.TextInputShort}}
+}
+
+// NewParagraphInput creates a new text input builder for a multi-line text input.
+func NewParagraphInput(customID, label string) *TextInputBuilder {
+	return &TextInputBuilder{input: discordgo.TextInput{CustomID: customID, Label: label, Style: discordgo.TextInputParagraph}}
+}
+
+// WithPlaceholder sets the text shown when the input is empty.
+func (b *TextInputBuilder) WithPlaceholder(placeholder string) *TextInputBuilder {
+	b.input.Placeholder = placeholder
+	return b
+}
+
+// WithValue sets the default value of the input.
+func (b *TextInputBuilder) WithValue(value string) *TextInputBuilder {
+	b.input.Value = value
+	return b
+}
+
+// WithRequired sets whether the input must be filled in before the modal can be submitted.
+func (b *TextInputBuilder) WithRequired(required bool) *TextInputBuilder {
+	b.input.Required = required
+	return b
+}
+
+// WithMinLength sets the minimum length of the input.
+func (b *TextInputBuilder) WithMinLength(minLength int) *TextInputBuilder {
+	b.input.MinLength = minLength
+	return b
+}
+
+// WithMaxLength sets the maximum length of the input.
+func (b *TextInputBuilder) WithMaxLength(maxLength int) *TextInputBuilder {
+	b.input.MaxLength = maxLength
+	return b
+}
+
+// Build creates the text input.
+func (b *TextInputBuilder) Build() discordgo.TextInput {
+	return b.input
+}
+
+// Modal is a Discord modal, a popup form shown in response to an interaction.
+type Modal struct {
+	customID string
+	title    string
+	inputs   []discordgo.TextInput
+}
+
+// NewModal creates a new modal with the given custom ID and title.
+func NewModal(customID, title string) *Modal {
+	return &Modal{customID: customID, title: title}
+}
+
+// AddInput adds the text inputs to the modal. Each input is shown on its own row.
+func (m *Modal) AddInput(inputs ...*TextInputBuilder) *Modal {
+	for _, input := range inputs {
+		m.inputs = append(m.inputs, input.Build())
+	}
+	return m
+}
+
+// Components returns the modal's text inputs, each wrapped in its own action row.
+func (m *Modal) Components() []discordgo.MessageComponent {
+	components := make([]discordgo.MessageComponent, 0, len(m.inputs))
+	for _, input := range m.inputs {
+		components = append(components, discordgo.ActionsRow{Components: []discordgo.MessageComponent{input}})
+	}
+	return components
+}
+
+// SendModal validates the modal against Discord's limits and sends it as the response to the interaction. A modal
+// may only be the first response to an interaction, so ErrAlreadyResponded is returned if the interaction has
+// already been deferred or responded to.
+func (r *Response) SendModal(s Sender, i *discordgo.Interaction, modal *Modal, options ...discordgo.RequestOption) error {
+	return r.SendModalContext(context.Background(), s, i, modal, options...)
+}
+
+// SendModalContext is like SendModal, but the requests are bound to the context and any pending retries are
+// abandoned once the context is done.
+func (r *Response) SendModalContext(ctx context.Context, s Sender, i *discordgo.Interaction, modal *Modal, options ...discordgo.RequestOption) error {
+	r.bindInteraction(i)
+	if err := r.checkCanRespond(); err != nil {
+		return err
+	}
+	if r.state == InteractionStateDeferred {
+		return ErrAlreadyResponded
+	}
+	responseType := discordgo.InteractionResponseModal
+	r.responseType = &responseType
+	r.customID = modal.customID
+	r.title = modal.title
+	WithComponents(modal.Components())((*message)(r))
+	if err := (*message)(r).validateModal(); err != nil {
+		return err
+	}
+	return r.SendContext(ctx, s, i, options...)
+}
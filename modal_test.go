@@ -0,0 +1,116 @@
+package disgomsg
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestTextInputBuilder(t *testing.T) {
+	input := NewParagraphInput("feedback", "Feedback").
+		WithPlaceholder("Tell us more").
+		WithValue("Great!").
+		WithRequired(true).
+		WithMinLength(5).
+		WithMaxLength(500).
+		Build()
+	expected := discordgo.TextInput{
+		CustomID:    "feedback",
+		Label:       "Feedback",
+		Style:       discordgo.TextInputParagraph,
+		Placeholder: "Tell us more",
+		Value:       "Great!",
+		Required:    true,
+		MinLength:   5,
+		MaxLength:   500,
+	}
+	if input != expected {
+		t.Errorf("Expected %+v, got %+v", expected, input)
+	}
+
+	if short := NewShortInput("name", "Name").Build(); short.Style != discordgo.TextInputShort {
+		t.Errorf("Expected short style, got %v", short.Style)
+	}
+}
+
+func TestModalComponents(t *testing.T) {
+	modal := NewModal("survey", "Survey").AddInput(
+		NewShortInput("name", "Name"),
+		NewParagraphInput("feedback", "Feedback"),
+	)
+	components := modal.Components()
+	if len(components) != 2 {
+		t.Fatalf("Expected 2 rows, got %d", len(components))
+	}
+	for i, component := range components {
+		row, ok := component.(discordgo.ActionsRow)
+		if !ok || len(row.Components) != 1 {
+			t.Errorf("Expected row %d to contain a single input, got %+v", i, component)
+		}
+	}
+}
+
+func TestResponseSendModal(t *testing.T) {
+	rec := NewRecorder()
+	interaction := &discordgo.Interaction{ID: "interaction-1"}
+	modal := NewModal("survey", "Survey").AddInput(NewShortInput("name", "Name").WithRequired(true))
+
+	if err := NewResponse().SendModal(rec, interaction, modal); err != nil {
+		t.Fatalf("SendModal returned error: %v", err)
+	}
+	req, _ := rec.Last()
+	if req.Method != "InteractionRespond" {
+		t.Fatalf("Expected InteractionRespond, got %q", req.Method)
+	}
+	if req.InteractionResponse.Type != discordgo.InteractionResponseModal {
+		t.Errorf("Expected modal response type, got %v", req.InteractionResponse.Type)
+	}
+	data := req.InteractionResponse.Data
+	if data.CustomID != "survey" || data.Title != "Survey" || len(data.Components) != 1 {
+		t.Errorf("Unexpected modal data %+v", data)
+	}
+}
+
+func TestResponseSendModalAfterDefer(t *testing.T) {
+	rec := NewRecorder()
+	interaction := &discordgo.Interaction{ID: "interaction-1"}
+	r := NewResponse(WithContent("Working"))
+	if err := r.Defer(rec, interaction, false); err != nil {
+		t.Fatalf("Defer returned error: %v", err)
+	}
+
+	modal := NewModal("survey", "Survey").AddInput(NewShortInput("name", "Name"))
+	if err := r.SendModal(rec, interaction, modal); !errors.Is(err, ErrAlreadyResponded) {
+		t.Fatalf("Expected ErrAlreadyResponded, got %v", err)
+	}
+	if len(rec.Requests()) != 1 {
+		t.Errorf("Expected only the deferral to be sent, got %d requests", len(rec.Requests()))
+	}
+	if r.responseType != nil || r.customID != "" || r.title != "" || len(r.components) != 0 {
+		t.Error("Expected the rejected modal to leave the response unchanged")
+	}
+}
+
+func TestResponseSendModalInvalid(t *testing.T) {
+	rec := NewRecorder()
+	modal := NewModal(strings.Repeat("c", MaxCustomIDLength+1), strings.Repeat("t", MaxModalTitleLength+1))
+	for i := 0; i <= MaxModalComponents; i++ {
+		modal.AddInput(NewShortInput("input", "Input"))
+	}
+
+	err := NewResponse().SendModal(rec, &discordgo.Interaction{}, modal)
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("Expected validation error, got %v", err)
+	}
+	fields := validationFields(err)
+	for _, field := range []string{"title", "customID", "components"} {
+		if !hasField(fields, field) {
+			t.Errorf("Expected violation for %q, got %v", field, fields)
+		}
+	}
+	if len(rec.Requests()) != 0 {
+		t.Error("Expected no request to be sent for an invalid modal")
+	}
+}
@@ -0,0 +1,192 @@
+package disgomsg
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const modalTag = "modal"
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// ModalFieldError reports a problem with the value submitted for a single text input in a modal.
+type ModalFieldError struct {
+	CustomID string
+	Err      error
+}
+
+// Error returns the custom ID of the text input and the reason its value was rejected.
+func (e *ModalFieldError) Error() string {
+	return e.CustomID + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *ModalFieldError) Unwrap() error {
+	return e.Err
+}
+
+// ModalError reports all problems found when decoding a modal submission.
+type ModalError struct {
+	Fields []*ModalFieldError
+}
+
+// Error returns a description of every field error, separated by newlines.
+func (e *ModalError) Error() string {
+	messages := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		messages = append(messages, f.Error())
+	}
+	return strings.Join(messages, "\n")
+}
+
+// Unwrap returns the field errors, so they may be matched with errors.Is and errors.As.
+func (e *ModalError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		errs = append(errs, f)
+	}
+	return errs
+}
+
+// Response creates an ephemeral response listing each field error, which may be sent back to the user who
+// submitted the modal.
+func (e *ModalError) Response() *Response {
+	var sb strings.Builder
+	sb.WriteString("Please correct the following and try again:")
+	for _, f := range e.Fields {
+		sb.WriteString("\n- **" + f.CustomID + "**: " + f.Err.Error())
+	}
+	return NewResponse(
+		WithContent(sb.String()),
+		WithFlags(discordgo.MessageFlagsEphemeral),
+	)
+}
+
+// DecodeModal decodes the values submitted in a modal into the struct pointed to by v. Struct fields are mapped to
+// text inputs using a `modal:"custom_id"` tag; adding `,required` to the tag rejects a missing or empty value.
+// Fields may be strings, integers, floating point numbers, booleans or time.Duration values. If any fields cannot
+// be decoded, a *ModalError is returned describing each of them.
+func DecodeModal(i *discordgo.Interaction, v any) error {
+	if i == nil || i.Type != discordgo.InteractionModalSubmit {
+		return ErrNotModalSubmit
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("disgomsg: DecodeModal requires a non-nil pointer to a struct, got %T", v)
+	}
+
+	values := modalValues(i.ModalSubmitData().Components)
+	rv = rv.Elem()
+	rt := rv.Type()
+	modalErr := &ModalError{}
+	for n := 0; n < rt.NumField(); n++ {
+		field := rt.Field(n)
+		tag, ok := field.Tag.Lookup(modalTag)
+		if !ok || tag == "-" || !field.IsExported() {
+			continue
+		}
+		customID, opts, _ := strings.Cut(tag, ",")
+		required := opts == "required"
+
+		value, present := values[customID]
+		if !present || value == "" {
+			if required {
+				modalErr.Fields = append(modalErr.Fields, &ModalFieldError{CustomID: customID, Err: ErrFieldRequired})
+			}
+			continue
+		}
+		if err := setModalField(rv.Field(n), value); err != nil {
+			modalErr.Fields = append(modalErr.Fields, &ModalFieldError{CustomID: customID, Err: err})
+		}
+	}
+
+	if len(modalErr.Fields) > 0 {
+		return modalErr
+	}
+	return nil
+}
+
+// modalValues returns the values of all text inputs in the submitted components, keyed by custom ID.
+func modalValues(components []discordgo.MessageComponent) map[string]string {
+	values := make(map[string]string)
+	for _, component := range components {
+		row, ok := asActionsRow(component)
+		if !ok {
+			continue
+		}
+		for _, child := range row.Components {
+			switch input := child.(type) {
+			case discordgo.TextInput:
+				values[input.CustomID] = input.Value
+			case *discordgo.TextInput:
+				values[input.CustomID] = input.Value
+			}
+		}
+	}
+	return values
+}
+
+// setModalField converts the submitted value to the field's type and stores it in the field.
+func setModalField(field reflect.Value, value string) error {
+	value = strings.TrimSpace(value)
+	if field.Type() == durationType {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("%q is not a valid duration", value)
+		}
+		field.SetInt(int64(d))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%q is not a valid whole number", value)
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%q is not a valid positive whole number", value)
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%q is not a valid number", value)
+		}
+		field.SetFloat(f)
+	case reflect.Bool:
+		b, err := parseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
+
+// parseBool parses a boolean, accepting yes and no in addition to the values accepted by strconv.ParseBool.
+func parseBool(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "yes", "y":
+		return true, nil
+	case "no", "n":
+		return false, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("%q is not yes or no", value)
+	}
+	return b, nil
+}
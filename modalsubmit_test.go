@@ -0,0 +1,103 @@
+package disgomsg
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// modalSubmit creates a modal submission interaction with a text input for each of the values.
+func modalSubmit(values map[string]string) *discordgo.Interaction {
+	var components []discordgo.MessageComponent
+	for customID, value := range values {
+		components = append(components, &discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			&discordgo.TextInput{CustomID: customID, Value: value},
+		}})
+	}
+	return &discordgo.Interaction{
+		Type: discordgo.InteractionModalSubmit,
+		Data: discordgo.ModalSubmitInteractionData{CustomID: "modal", Components: components},
+	}
+}
+
+type surveyForm struct {
+	Name     string        `modal:"name,required"`
+	Age      int           `modal:"age"`
+	Rating   float64       `modal:"rating"`
+	Agree    bool          `modal:"agree"`
+	Timeout  time.Duration `modal:"timeout"`
+	Count    uint8         `modal:"count"`
+	Ignored  string        `modal:"-"`
+	Untagged string
+}
+
+func TestDecodeModal(t *testing.T) {
+	i := modalSubmit(map[string]string{
+		"name":    "Alice",
+		"age":     " 42 ",
+		"rating":  "4.5",
+		"agree":   "yes",
+		"timeout": "1m30s",
+		"count":   "7",
+	})
+	var form surveyForm
+	if err := DecodeModal(i, &form); err != nil {
+		t.Fatalf("DecodeModal returned error: %v", err)
+	}
+	expected := surveyForm{Name: "Alice", Age: 42, Rating: 4.5, Agree: true, Timeout: 90 * time.Second, Count: 7}
+	if form != expected {
+		t.Errorf("Expected %+v, got %+v", expected, form)
+	}
+}
+
+func TestDecodeModalErrors(t *testing.T) {
+	i := modalSubmit(map[string]string{
+		"age":     "old",
+		"rating":  "high",
+		"agree":   "maybe",
+		"timeout": "soon",
+		"count":   "300",
+	})
+	var form surveyForm
+	err := DecodeModal(i, &form)
+
+	var modalErr *ModalError
+	if !errors.As(err, &modalErr) {
+		t.Fatalf("Expected *ModalError, got %v", err)
+	}
+	if len(modalErr.Fields) != 6 {
+		t.Errorf("Expected 6 field errors, got %d: %v", len(modalErr.Fields), err)
+	}
+	if !errors.Is(err, ErrFieldRequired) {
+		t.Error("Expected missing name to be reported as ErrFieldRequired")
+	}
+	if modalErr.Fields[0].CustomID != "name" {
+		t.Errorf("Expected first error for name, got %q", modalErr.Fields[0].CustomID)
+	}
+
+	resp := modalErr.Response()
+	if resp.flags&discordgo.MessageFlagsEphemeral == 0 {
+		t.Error("Expected error response to be ephemeral")
+	}
+	for _, customID := range []string{"name", "age", "rating", "agree", "timeout", "count"} {
+		if !strings.Contains(resp.content, customID) {
+			t.Errorf("Expected response to mention %q, got %q", customID, resp.content)
+		}
+	}
+}
+
+func TestDecodeModalInvalidInput(t *testing.T) {
+	var form surveyForm
+	if err := DecodeModal(&discordgo.Interaction{Type: discordgo.InteractionApplicationCommand}, &form); !errors.Is(err, ErrNotModalSubmit) {
+		t.Errorf("Expected ErrNotModalSubmit, got %v", err)
+	}
+	if err := DecodeModal(nil, &form); !errors.Is(err, ErrNotModalSubmit) {
+		t.Errorf("Expected ErrNotModalSubmit, got %v", err)
+	}
+	if err := DecodeModal(modalSubmit(nil), form); err == nil {
+		t.Error("Expected error when decoding into a non-pointer")
+	}
+}
@@ -0,0 +1,420 @@
+package disgomsg
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// RecordedRequest is a single request captured by a Recorder.
+type RecordedRequest struct {
+	Method              string
+	ChannelID           string
+	MessageID           string
+	RecipientID         string
+	WebhookID           string
+	ThreadID            string
+	Interaction         *discordgo.Interaction
+	MessageSend         *discordgo.MessageSend
+	MessageEdit         *discordgo.MessageEdit
+	InteractionResponse *discordgo.InteractionResponse
+	WebhookEdit         *discordgo.WebhookEdit
+	WebhookParams       *discordgo.WebhookParams
+	ThreadStart         *discordgo.ThreadStart
+	Options             []discordgo.RequestOption
+}
+
+// Recorder is an in-memory Sender that records every request instead of sending it to Discord. It is intended
+// for unit testing code that uses disgomsg. A Recorder is safe for concurrent use.
+type Recorder struct {
+	mu       sync.Mutex
+	requests []RecordedRequest
+	lastID   int
+
+	// Errors maps a method name, such as "ChannelMessageSendComplex", to the error that method returns. The
+	// request is still recorded when an error is returned.
+	Errors map[string]error
+
+	// Messages maps a message ID to the message returned by ChannelMessage. Fetching any other message, or a
+	// message from a different channel, fails with Discord's unknown message error.
+	Messages map[string]*discordgo.Message
+
+	// Channels maps a channel ID to the channel returned by Channel. Fetching any other channel fails with
+	// Discord's unknown channel error.
+	Channels map[string]*discordgo.Channel
+}
+
+// NewRecorder creates a new, empty recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Requests returns a copy of all requests recorded so far, in the order they were made.
+func (r *Recorder) Requests() []RecordedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	requests := make([]RecordedRequest, len(r.requests))
+	copy(requests, r.requests)
+	return requests
+}
+
+// Last returns the most recently recorded request, or false if no requests have been recorded.
+func (r *Recorder) Last() (RecordedRequest, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.requests) == 0 {
+		return RecordedRequest{}, false
+	}
+	return r.requests[len(r.requests)-1], true
+}
+
+// Reset clears all recorded requests.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = nil
+}
+
+// record stores the request and returns the error configured for its method, if any.
+func (r *Recorder) record(req RecordedRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, req)
+	return r.Errors[req.Method]
+}
+
+// nextID returns a new unique snowflake-like ID.
+func (r *Recorder) nextID() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastID++
+	return strconv.Itoa(r.lastID)
+}
+
+// ChannelMessageSendComplex records the message and returns a message with a newly generated ID.
+func (r *Recorder) ChannelMessageSendComplex(channelID string, data *discordgo.MessageSend, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	err := r.record(RecordedRequest{
+		Method:      "ChannelMessageSendComplex",
+		ChannelID:   channelID,
+		MessageSend: data,
+		Options:     options,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &discordgo.Message{
+		ID:        r.nextID(),
+		ChannelID: channelID,
+		Content:   data.Content,
+		Embeds:    data.Embeds,
+	}, nil
+}
+
+// ChannelMessageEditComplex records the edit and returns the edited message.
+func (r *Recorder) ChannelMessageEditComplex(m *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	err := r.record(RecordedRequest{
+		Method:      "ChannelMessageEditComplex",
+		ChannelID:   m.Channel,
+		MessageID:   m.ID,
+		MessageEdit: m,
+		Options:     options,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &discordgo.Message{ID: m.ID, ChannelID: m.Channel}, nil
+}
+
+// ChannelMessageDelete records the deletion.
+func (r *Recorder) ChannelMessageDelete(channelID, messageID string, options ...discordgo.RequestOption) error {
+	return r.record(RecordedRequest{
+		Method:    "ChannelMessageDelete",
+		ChannelID: channelID,
+		MessageID: messageID,
+		Options:   options,
+	})
+}
+
+// UserChannelCreate records the request and returns a private channel with a newly generated ID.
+func (r *Recorder) UserChannelCreate(recipientID string, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+	err := r.record(RecordedRequest{
+		Method:      "UserChannelCreate",
+		RecipientID: recipientID,
+		Options:     options,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &discordgo.Channel{ID: r.nextID(), Type: discordgo.ChannelTypeDM}, nil
+}
+
+// InteractionRespond records the interaction response.
+func (r *Recorder) InteractionRespond(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse, options ...discordgo.RequestOption) error {
+	return r.record(RecordedRequest{
+		Method:              "InteractionRespond",
+		Interaction:         interaction,
+		InteractionResponse: resp,
+		Options:             options,
+	})
+}
+
+// InteractionResponseEdit records the edit and returns the edited response message.
+func (r *Recorder) InteractionResponseEdit(interaction *discordgo.Interaction, newresp *discordgo.WebhookEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	err := r.record(RecordedRequest{
+		Method:      "InteractionResponseEdit",
+		Interaction: interaction,
+		WebhookEdit: newresp,
+		Options:     options,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &discordgo.Message{ID: "@original", ChannelID: interaction.ChannelID}, nil
+}
+
+// InteractionResponseDelete records the deletion.
+func (r *Recorder) InteractionResponseDelete(interaction *discordgo.Interaction, options ...discordgo.RequestOption) error {
+	return r.record(RecordedRequest{
+		Method:      "InteractionResponseDelete",
+		Interaction: interaction,
+		Options:     options,
+	})
+}
+
+// FollowupMessageCreate records the follow-up message and returns a message with a newly generated ID.
+func (r *Recorder) FollowupMessageCreate(interaction *discordgo.Interaction, wait bool, data *discordgo.WebhookParams, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	err := r.record(RecordedRequest{
+		Method:        "FollowupMessageCreate",
+		Interaction:   interaction,
+		WebhookParams: data,
+		Options:       options,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &discordgo.Message{
+		ID:        r.nextID(),
+		ChannelID: interaction.ChannelID,
+		Content:   data.Content,
+		Embeds:    data.Embeds,
+		Flags:     data.Flags,
+	}, nil
+}
+
+// FollowupMessageEdit records the edit and returns the edited follow-up message.
+func (r *Recorder) FollowupMessageEdit(interaction *discordgo.Interaction, messageID string, data *discordgo.WebhookEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	err := r.record(RecordedRequest{
+		Method:      "FollowupMessageEdit",
+		Interaction: interaction,
+		MessageID:   messageID,
+		WebhookEdit: data,
+		Options:     options,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &discordgo.Message{ID: messageID, ChannelID: interaction.ChannelID}, nil
+}
+
+// FollowupMessageDelete records the deletion.
+func (r *Recorder) FollowupMessageDelete(interaction *discordgo.Interaction, messageID string, options ...discordgo.RequestOption) error {
+	return r.record(RecordedRequest{
+		Method:      "FollowupMessageDelete",
+		Interaction: interaction,
+		MessageID:   messageID,
+		Options:     options,
+	})
+}
+
+// ChannelMessage records the request and returns the matching message from Messages.
+func (r *Recorder) ChannelMessage(channelID, messageID string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	err := r.record(RecordedRequest{
+		Method:    "ChannelMessage",
+		ChannelID: channelID,
+		MessageID: messageID,
+		Options:   options,
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	msg, ok := r.Messages[messageID]
+	if !ok || (msg.ChannelID != "" && msg.ChannelID != channelID) {
+		return nil, &discordgo.RESTError{
+			Response:     &http.Response{StatusCode: http.StatusNotFound, Status: "404 Not Found"},
+			ResponseBody: []byte(`{"message": "Unknown Message", "code": 10008}`),
+			Message:      &discordgo.APIErrorMessage{Code: discordgo.ErrCodeUnknownMessage, Message: "Unknown Message"},
+		}
+	}
+	return msg, nil
+}
+
+// WebhookExecute records the webhook message and returns a message with a newly generated ID.
+func (r *Recorder) WebhookExecute(webhookID, token string, wait bool, data *discordgo.WebhookParams, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	return r.WebhookThreadExecute(webhookID, token, wait, "", data, options...)
+}
+
+// WebhookThreadExecute records the webhook message sent to the thread and returns a message with a newly generated
+// ID. If a thread name is given, the message is placed in a new thread with a newly generated ID.
+func (r *Recorder) WebhookThreadExecute(webhookID, token string, wait bool, threadID string, data *discordgo.WebhookParams, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	method := "WebhookExecute"
+	if threadID != "" {
+		method = "WebhookThreadExecute"
+	}
+	err := r.record(RecordedRequest{
+		Method:        method,
+		WebhookID:     webhookID,
+		ThreadID:      threadID,
+		WebhookParams: data,
+		Options:       options,
+	})
+	if err != nil {
+		return nil, err
+	}
+	channelID := threadID
+	if channelID == "" && data.ThreadName != "" {
+		channelID = r.nextID()
+	}
+	return &discordgo.Message{
+		ID:        r.nextID(),
+		ChannelID: channelID,
+		Content:   data.Content,
+		Embeds:    data.Embeds,
+		Flags:     data.Flags,
+	}, nil
+}
+
+// WebhookMessageEdit records the edit and returns the edited webhook message.
+func (r *Recorder) WebhookMessageEdit(webhookID, token, messageID string, data *discordgo.WebhookEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	threadID := requestQuery(options).Get("thread_id")
+	err := r.record(RecordedRequest{
+		Method:      "WebhookMessageEdit",
+		WebhookID:   webhookID,
+		ThreadID:    threadID,
+		MessageID:   messageID,
+		WebhookEdit: data,
+		Options:     options,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &discordgo.Message{ID: messageID, ChannelID: threadID}, nil
+}
+
+// WebhookMessageDelete records the deletion.
+func (r *Recorder) WebhookMessageDelete(webhookID, token, messageID string, options ...discordgo.RequestOption) error {
+	return r.record(RecordedRequest{
+		Method:    "WebhookMessageDelete",
+		WebhookID: webhookID,
+		ThreadID:  requestQuery(options).Get("thread_id"),
+		MessageID: messageID,
+		Options:   options,
+	})
+}
+
+// MessageThreadStartComplex records the request and returns a thread with a newly generated ID.
+func (r *Recorder) MessageThreadStartComplex(channelID, messageID string, data *discordgo.ThreadStart, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+	err := r.record(RecordedRequest{
+		Method:      "MessageThreadStartComplex",
+		ChannelID:   channelID,
+		MessageID:   messageID,
+		ThreadStart: data,
+		Options:     options,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &discordgo.Channel{
+		ID:       r.nextID(),
+		ParentID: channelID,
+		Name:     data.Name,
+		Type:     discordgo.ChannelTypeGuildPublicThread,
+	}, nil
+}
+
+// ThreadStartComplex records the request and returns a thread with a newly generated ID.
+func (r *Recorder) ThreadStartComplex(channelID string, data *discordgo.ThreadStart, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+	err := r.record(RecordedRequest{
+		Method:      "ThreadStartComplex",
+		ChannelID:   channelID,
+		ThreadStart: data,
+		Options:     options,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &discordgo.Channel{
+		ID:       r.nextID(),
+		ParentID: channelID,
+		Name:     data.Name,
+		Type:     data.Type,
+	}, nil
+}
+
+// Channel records the request and returns the matching channel from Channels.
+func (r *Recorder) Channel(channelID string, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+	err := r.record(RecordedRequest{
+		Method:    "Channel",
+		ChannelID: channelID,
+		Options:   options,
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	channel, ok := r.Channels[channelID]
+	if !ok {
+		return nil, &discordgo.RESTError{
+			Response:     &http.Response{StatusCode: http.StatusNotFound, Status: "404 Not Found"},
+			ResponseBody: []byte(`{"message": "Unknown Channel", "code": 10003}`),
+			Message:      &discordgo.APIErrorMessage{Code: discordgo.ErrCodeUnknownChannel, Message: "Unknown Channel"},
+		}
+	}
+	return channel, nil
+}
+
+// ForumThreadStartComplex records the forum post and returns a thread with a newly generated ID, which is also the
+// ID of its starter message.
+func (r *Recorder) ForumThreadStartComplex(channelID string, threadData *discordgo.ThreadStart, messageData *discordgo.MessageSend, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+	err := r.record(RecordedRequest{
+		Method:      "ForumThreadStartComplex",
+		ChannelID:   channelID,
+		ThreadStart: threadData,
+		MessageSend: messageData,
+		Options:     options,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &discordgo.Channel{
+		ID:          r.nextID(),
+		ParentID:    channelID,
+		Name:        threadData.Name,
+		Type:        discordgo.ChannelTypeGuildPublicThread,
+		AppliedTags: threadData.AppliedTags,
+	}, nil
+}
+
+// requestQuery returns the query parameters the request options add to a request.
+func requestQuery(options []discordgo.RequestOption) url.Values {
+	req, _ := http.NewRequest(http.MethodGet, discordgo.EndpointAPI, nil)
+	cfg := &discordgo.RequestConfig{Request: req}
+	for _, opt := range options {
+		opt(cfg)
+	}
+	return cfg.Request.URL.Query()
+}
+
+var (
+	_ Sender         = (*Recorder)(nil)
+	_ FollowupSender = (*Recorder)(nil)
+	_ MessageFetcher = (*Recorder)(nil)
+	_ WebhookSender  = (*Recorder)(nil)
+	_ ThreadSender   = (*Recorder)(nil)
+	_ ForumSender    = (*Recorder)(nil)
+)
@@ -0,0 +1,76 @@
+package disgomsg
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestRecorderRecordsRequests(t *testing.T) {
+	rec := NewRecorder()
+	if _, ok := rec.Last(); ok {
+		t.Error("Expected no requests on a new recorder")
+	}
+
+	sent, err := rec.ChannelMessageSendComplex("channel-1", &discordgo.MessageSend{Content: "hello"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if sent.ID == "" || sent.ChannelID != "channel-1" || sent.Content != "hello" {
+		t.Errorf("Unexpected message %+v", sent)
+	}
+	channel, err := rec.UserChannelCreate("user-1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if channel.ID == "" || channel.ID == sent.ID {
+		t.Errorf("Expected a new unique channel ID, got %q", channel.ID)
+	}
+
+	requests := rec.Requests()
+	if len(requests) != 2 {
+		t.Fatalf("Expected 2 requests, got %d", len(requests))
+	}
+	if requests[0].Method != "ChannelMessageSendComplex" || requests[1].Method != "UserChannelCreate" {
+		t.Errorf("Unexpected methods %q, %q", requests[0].Method, requests[1].Method)
+	}
+
+	rec.Reset()
+	if len(rec.Requests()) != 0 {
+		t.Error("Expected no requests after Reset")
+	}
+}
+
+func TestRecorderErrors(t *testing.T) {
+	deleteErr := errors.New("delete failed")
+	rec := NewRecorder()
+	rec.Errors = map[string]error{"ChannelMessageDelete": deleteErr}
+
+	if err := rec.ChannelMessageDelete("channel-1", "message-1"); !errors.Is(err, deleteErr) {
+		t.Errorf("Expected %v, got %v", deleteErr, err)
+	}
+	req, ok := rec.Last()
+	if !ok || req.Method != "ChannelMessageDelete" {
+		t.Errorf("Expected failed request to be recorded, got %+v", req)
+	}
+}
+
+func TestRecorderConcurrentUse(t *testing.T) {
+	rec := NewRecorder()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = rec.ChannelMessageSendComplex("channel-1", &discordgo.MessageSend{})
+		}()
+	}
+	wg.Wait()
+
+	requests := rec.Requests()
+	if len(requests) != 10 {
+		t.Fatalf("Expected 10 requests, got %d", len(requests))
+	}
+}
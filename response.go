@@ -1,7 +1,7 @@
 package disgomsg
 
 import (
-	"errors"
+	"context"
 
 	"github.com/bwmarrin/discordgo"
 )
@@ -15,98 +15,167 @@ func NewResponse(opts ...Option) *Response {
 	return (*Response)(message)
 }
 
-// Send sends the interaction response to the specified channel using the provided Discord session.
-func (r *Response) Send(s *discordgo.Session, i *discordgo.Interaction, options ...discordgo.RequestOption) error {
-	var respType discordgo.InteractionResponseType
-	if r.responseType == nil {
-		respType = discordgo.InteractionResponseChannelMessageWithSource
-	} else {
-		respType = *r.responseType
+// Send sends the interaction response to the specified channel using the provided Discord session. If the response
+// has been deferred, the original response is edited instead. ErrAlreadyResponded is returned if a reply has
+// already been sent.
+func (r *Response) Send(s Sender, i *discordgo.Interaction, options ...discordgo.RequestOption) error {
+	return r.SendContext(context.Background(), s, i, options...)
+}
+
+// SendContext is like Send, but the requests are bound to the context and any pending retries are abandoned once the
+// context is done.
+func (r *Response) SendContext(ctx context.Context, s Sender, i *discordgo.Interaction, options ...discordgo.RequestOption) error {
+	if r.validate {
+		if err := r.Validate(); err != nil {
+			return err
+		}
 	}
-	response := &discordgo.InteractionResponse{
-		Type: respType,
-		Data: &discordgo.InteractionResponseData{
-			AllowedMentions: r.allowedMentions,
-			Attachments:     &r.attachments,
-			Components:      r.components,
-			Content:         r.content,
-			Embeds:          r.embeds,
-			Files:           r.files,
-			Flags:           r.flags,
-			Choices:         r.choices,
-			CustomID:        r.customID,
-			Title:           r.title,
-		},
-	}
-	r.interaction = i
-	err := s.InteractionRespond(r.interaction, response, options...)
+	r.bindInteraction(i)
+	if err := r.checkCanRespond(); err != nil {
+		return err
+	}
+	if r.state == InteractionStateDeferred {
+		return r.sendDeferred(ctx, s, options...)
+	}
+	response := (*message)(r).toInteractionResponse()
+	err := (*message)(r).do(ctx, "respond to interaction", options, func(options ...discordgo.RequestOption) error {
+		return s.InteractionRespond(r.interaction, response, options...)
+	})
 	if err != nil {
 		return err
 	}
+	r.state = InteractionStateResponded
+	r.respondedAt = now()
+	(*message)(r).markSent()
 
 	return nil
 }
 
-// SendEphemeral sends the interaction response as an ephemeral message to the specified channel using the provided Discord session.
-func (r *Response) SendEphemeral(s *discordgo.Session, i *discordgo.Interaction, options ...discordgo.RequestOption) error {
-	r.flags ^= discordgo.MessageFlagsEphemeral
-	return r.Send(s, i, options...)
+// Defer acknowledges the interaction without sending a reply, showing the user a loading state. Subsequent calls to
+// Send edit the original response rather than responding to the interaction. Whether the eventual reply is
+// ephemeral must be decided when deferring.
+func (r *Response) Defer(s Sender, i *discordgo.Interaction, ephemeral bool, options ...discordgo.RequestOption) error {
+	return r.DeferContext(context.Background(), s, i, ephemeral, options...)
 }
 
-// Edit edits the existing interaction response using the provided Discord session and updates its content, components, embeds, and attachments.
-func (r *Response) Edit(s *discordgo.Session, options ...discordgo.RequestOption) error {
-	if r.interaction == nil {
-		return errors.New("missing interaction") // No interaction to delete
+// DeferContext is like Defer, but the requests are bound to the context and any pending retries are abandoned once
+// the context is done.
+func (r *Response) DeferContext(ctx context.Context, s Sender, i *discordgo.Interaction, ephemeral bool, options ...discordgo.RequestOption) error {
+	r.bindInteraction(i)
+	if err := r.checkCanRespond(); err != nil {
+		return err
 	}
-
-	webhookEdit := &discordgo.WebhookEdit{
-		Content:         &r.content,
-		Components:      &r.components,
-		Embeds:          &r.embeds,
-		Attachments:     &r.attachments,
-		AllowedMentions: r.allowedMentions,
+	if r.state == InteractionStateDeferred {
+		return ErrAlreadyResponded
+	}
+	response := &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	}
+	if ephemeral {
+		response.Data = &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral}
 	}
-	_, err := s.InteractionResponseEdit(r.interaction, webhookEdit, options...)
+	err := (*message)(r).do(ctx, "defer interaction", options, func(options ...discordgo.RequestOption) error {
+		return s.InteractionRespond(r.interaction, response, options...)
+	})
 	if err != nil {
 		return err
 	}
+	r.state = InteractionStateDeferred
+	r.deferredAt = now()
 
 	return nil
 }
 
-// Delete deletes the interaction response using the provided Discord session.
-func (r *Response) Delete(s *discordgo.Session, options ...discordgo.RequestOption) error {
-	if r.interaction == nil {
-		return errors.New("missing interaction") // No interaction to delete
-	}
-	err := s.InteractionResponseDelete(r.interaction, options...)
+// sendDeferred sends the response by editing the original response to a deferred interaction.
+func (r *Response) sendDeferred(ctx context.Context, s Sender, options ...discordgo.RequestOption) error {
+	webhookEdit := (*message)(r).toWebhookEdit()
+	err := (*message)(r).do(ctx, "edit interaction response", options, func(options ...discordgo.RequestOption) error {
+		_, err := s.InteractionResponseEdit(r.interaction, webhookEdit, options...)
+		return err
+	})
 	if err != nil {
 		return err
 	}
+	r.state = InteractionStateResponded
+	r.respondedAt = now()
+	(*message)(r).markSent()
 
 	return nil
 }
 
-// WithInteraction sets the interaction for the response.
-func (r *Response) WithInteraction(i *discordgo.Interaction) *Response {
-	r.interaction = i
-	return r
+// SendEphemeral sends the interaction response as an ephemeral message using the provided Discord session. The
+// response remains ephemeral if it is sent again, such as after a failure.
+func (r *Response) SendEphemeral(s Sender, i *discordgo.Interaction, options ...discordgo.RequestOption) error {
+	return r.SendEphemeralContext(context.Background(), s, i, options...)
 }
 
-// WithContent sets the content for the message.
-func (r *Response) WithContent(content string) *Response {
-	r.content = content
-	return r
+// SendEphemeralContext is like SendEphemeral, but the requests are bound to the context and any pending retries are
+// abandoned once the context is done.
+func (r *Response) SendEphemeralContext(ctx context.Context, s Sender, i *discordgo.Interaction, options ...discordgo.RequestOption) error {
+	r.SetEphemeral(true)
+	return r.SendContext(ctx, s, i, options...)
 }
 
-// WithEmbeds sets the embeds for the message.
-func (r *Response) WithEmbeds(embeds []*discordgo.MessageEmbed) *Response {
-	r.embeds = embeds
-	return r
+// Edit edits the existing interaction response using the provided Discord session. Only the fields changed since the
+// response was last sent or edited are updated, unless WithFullEdit is set.
+func (r *Response) Edit(s Sender, options ...discordgo.RequestOption) error {
+	return r.EditContext(context.Background(), s, options...)
+}
+
+// EditContext is like Edit, but the requests are bound to the context and any pending retries are abandoned once the
+// context is done.
+func (r *Response) EditContext(ctx context.Context, s Sender, options ...discordgo.RequestOption) error {
+	if err := r.checkCanModify(); err != nil {
+		return err
+	}
+	if r.validate {
+		if err := (*message)(r).validateWebhookEdit(); err != nil {
+			return err
+		}
+	}
+
+	webhookEdit := (*message)(r).toWebhookEdit()
+	err := (*message)(r).do(ctx, "edit interaction response", options, func(options ...discordgo.RequestOption) error {
+		_, err := s.InteractionResponseEdit(r.interaction, webhookEdit, options...)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if r.state == InteractionStateDeferred {
+		r.state = InteractionStateResponded
+		r.respondedAt = now()
+	}
+	(*message)(r).markSent()
+
+	return nil
+}
+
+// Delete deletes the interaction response using the provided Discord session.
+func (r *Response) Delete(s Sender, options ...discordgo.RequestOption) error {
+	return r.DeleteContext(context.Background(), s, options...)
 }
 
-// WithComponents sets the components for the message.
-func (r *Response) WithComponents(components []discordgo.MessageComponent) *Response {
-	r.components = components
+// DeleteContext is like Delete, but the requests are bound to the context and any pending retries are abandoned once
+// the context is done.
+func (r *Response) DeleteContext(ctx context.Context, s Sender, options ...discordgo.RequestOption) error {
+	if err := r.checkCanModify(); err != nil {
+		return err
+	}
+	err := (*message)(r).do(ctx, "delete interaction response", options, func(options ...discordgo.RequestOption) error {
+		return s.InteractionResponseDelete(r.interaction, options...)
+	})
+	if err != nil {
+		return err
+	}
+	r.state = InteractionStateDeleted
+	r.deletedAt = now()
+
+	return nil
+}
+
+// WithInteraction sets the interaction for the response.
+func (r *Response) WithInteraction(i *discordgo.Interaction) *Response {
+	r.bindInteraction(i)
 	return r
 }
@@ -1,6 +1,7 @@
 package disgomsg
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/bwmarrin/discordgo"
@@ -83,7 +84,120 @@ func TestResponseWithMethods(t *testing.T) {
 	}
 }
 
-// Note: We can't easily test Send, SendEphemeral, Edit, and Delete methods
-// without mocking the discordgo.Session interface, which is quite large.
-// In a real-world scenario, you might use a mocking library or create a test
-// wrapper around the discordgo.Session interface.
+func TestResponseSendEditDelete(t *testing.T) {
+	rec := NewRecorder()
+	interaction := &discordgo.Interaction{ID: "interaction-1"}
+	resp := NewResponse(WithContent("hello"))
+
+	if err := resp.Send(rec, interaction); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	req, _ := rec.Last()
+	if req.Method != "InteractionRespond" || req.Interaction != interaction {
+		t.Errorf("Unexpected send request %+v", req)
+	}
+	if req.InteractionResponse.Type != discordgo.InteractionResponseChannelMessageWithSource {
+		t.Errorf("Expected default response type, got %v", req.InteractionResponse.Type)
+	}
+	if req.InteractionResponse.Data.Content != "hello" {
+		t.Errorf("Expected content %q, got %q", "hello", req.InteractionResponse.Data.Content)
+	}
+
+	if err := resp.WithContent("updated").Edit(rec); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	req, _ = rec.Last()
+	if req.Method != "InteractionResponseEdit" || *req.WebhookEdit.Content != "updated" {
+		t.Errorf("Unexpected edit request %+v", req)
+	}
+
+	if err := resp.Delete(rec); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	req, _ = rec.Last()
+	if req.Method != "InteractionResponseDelete" || req.Interaction != interaction {
+		t.Errorf("Unexpected delete request %+v", req)
+	}
+
+	if err := NewResponse().Edit(rec); !errors.Is(err, ErrMissingInteraction) {
+		t.Errorf("Expected ErrMissingInteraction, got %v", err)
+	}
+}
+
+func TestResponseDefer(t *testing.T) {
+	rec := NewRecorder()
+	interaction := &discordgo.Interaction{ID: "interaction-1"}
+	resp := NewResponse()
+
+	if err := resp.Defer(rec, interaction, true); err != nil {
+		t.Fatalf("Defer returned error: %v", err)
+	}
+	req, _ := rec.Last()
+	if req.Method != "InteractionRespond" || req.InteractionResponse.Type != discordgo.InteractionResponseDeferredChannelMessageWithSource {
+		t.Errorf("Unexpected defer request %+v", req)
+	}
+	if req.InteractionResponse.Data == nil || req.InteractionResponse.Data.Flags != discordgo.MessageFlagsEphemeral {
+		t.Error("Expected deferred response to be ephemeral")
+	}
+
+	files := []*discordgo.File{{Name: "report.txt"}}
+	resp.files = files
+	if err := resp.WithContent("done").Send(rec, interaction); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	req, _ = rec.Last()
+	if req.Method != "InteractionResponseEdit" || req.Interaction != interaction || *req.WebhookEdit.Content != "done" {
+		t.Errorf("Expected deferred send to edit the original response, got %+v", req)
+	}
+	if len(req.WebhookEdit.Files) != 1 {
+		t.Error("Expected files to be included in the deferred send")
+	}
+}
+
+func TestResponseDeferFailure(t *testing.T) {
+	rec := NewRecorder()
+	rec.Errors = map[string]error{"InteractionRespond": errors.New("unknown interaction")}
+	resp := NewResponse()
+	if err := resp.Defer(rec, &discordgo.Interaction{}, false); err == nil {
+		t.Fatal("Expected Defer to return an error")
+	}
+	if resp.State() != InteractionStateNew {
+		t.Error("Expected response not to be deferred after a failure")
+	}
+}
+
+func TestResponseEditChangedFields(t *testing.T) {
+	rec := NewRecorder()
+	interaction := &discordgo.Interaction{ID: "interaction-1"}
+	resp := NewResponse(
+		WithContent("hello"),
+		WithComponents([]discordgo.MessageComponent{discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			NewButton(discordgo.PrimaryButton, "OK", "ok").Build(),
+		}}}),
+	)
+	if err := resp.Send(rec, interaction); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+
+	if err := resp.WithEmbeds([]*discordgo.MessageEmbed{{Title: "Report"}}).Edit(rec); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	req, _ := rec.Last()
+	edit := req.WebhookEdit
+	if edit.Embeds == nil || len(*edit.Embeds) != 1 {
+		t.Errorf("Expected changed embeds to be sent, got %v", edit.Embeds)
+	}
+	if edit.Content != nil || edit.Components != nil || edit.Attachments != nil {
+		t.Errorf("Expected unchanged fields to be omitted, got %+v", edit)
+	}
+
+	WithFullEdit(true)((*message)(resp))
+	if err := resp.Edit(rec); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	req, _ = rec.Last()
+	edit = req.WebhookEdit
+	if edit.Content == nil || *edit.Content != "hello" || edit.Components == nil || edit.Embeds == nil {
+		t.Errorf("Expected a full edit to include all fields, got %+v", edit)
+	}
+}
@@ -0,0 +1,213 @@
+package disgomsg
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// RetryPolicy determines how failed requests to Discord are retried. Requests are retried when rate limited,
+// honoring the delay requested by Discord, when Discord returns a server error, and when no response is received.
+// Other errors, such as validation failures or responses that cannot be parsed, are never retried.
+type RetryPolicy struct {
+	MaxAttempts    int                // Maximum number of attempts, including the first. Values below 1 are treated as 1.
+	InitialBackoff time.Duration      // Delay before the first retry, doubled for each subsequent retry.
+	MaxBackoff     time.Duration      // Maximum delay between attempts, or zero for no maximum.
+	OnAttempt      func(RetryAttempt) // Called after each attempt, if set.
+}
+
+// RetryAttempt describes a single attempt at a request, passed to the RetryPolicy's OnAttempt hook.
+type RetryAttempt struct {
+	Op      string        // The operation being attempted, such as "send message".
+	Attempt int           // The attempt number, starting at 1.
+	Err     error         // The error returned by the attempt, or nil if it succeeded.
+	Retry   bool          // Whether the request will be retried.
+	Delay   time.Duration // The delay before the next attempt, if the request will be retried.
+}
+
+// DefaultRetryPolicy returns a policy that makes up to 3 attempts, starting with a 500ms backoff.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     10 * time.Second,
+	}
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests for the message.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(f *message) {
+		f.retryPolicy = policy
+	}
+}
+
+// sleep pauses for the duration, returning early with the context's error if it is done first. It is a variable so
+// tests need not wait.
+var sleep = func(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// do performs the operation with the context, retrying it according to the message's retry policy. Pending retries
+// are abandoned once the context is done. Any error returned by the final attempt is wrapped in an *APIError.
+func (m *message) do(ctx context.Context, op string, options []discordgo.RequestOption, call func(options ...discordgo.RequestOption) error) error {
+	if err := ctx.Err(); err != nil {
+		return wrapError(err, op, m)
+	}
+	// Options provided by the caller take precedence.
+	options = append([]discordgo.RequestOption{discordgo.WithContext(ctx)}, options...)
+
+	p := m.retryPolicy
+	if p == nil || p.MaxAttempts <= 1 {
+		return wrapError(call(options...), op, m)
+	}
+
+	// The policy handles retries, so disable those made by discordgo.
+	options = append([]discordgo.RequestOption{
+		discordgo.WithRetryOnRatelimit(false),
+		discordgo.WithRestRetries(0),
+	}, options...)
+	if err := bufferFiles(m.files); err != nil {
+		return wrapError(err, op, m)
+	}
+
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 {
+			if err := rewindFiles(m.files); err != nil {
+				return wrapError(err, op, m)
+			}
+		}
+		err := call(options...)
+		delay, retry := p.retryDelay(attempt, err)
+		if p.OnAttempt != nil {
+			p.OnAttempt(RetryAttempt{Op: op, Attempt: attempt, Err: err, Retry: retry, Delay: delay})
+		}
+		if !retry {
+			return wrapError(err, op, m)
+		}
+		if ctxErr := sleep(ctx, delay); ctxErr != nil {
+			return wrapError(fmt.Errorf("%w: %w", ctxErr, err), op, m)
+		}
+	}
+}
+
+// retryDelay reports whether a request that failed with the error on the given attempt should be retried, and
+// how long to wait before retrying.
+func (p *RetryPolicy) retryDelay(attempt int, err error) (time.Duration, bool) {
+	if err == nil || attempt >= p.MaxAttempts {
+		return 0, false
+	}
+
+	var rateLimitErr *discordgo.RateLimitError
+	if errors.As(err, &rateLimitErr) && rateLimitErr.RateLimit != nil && rateLimitErr.TooManyRequests != nil {
+		return rateLimitErr.RetryAfter, true
+	}
+	var restErr *discordgo.RESTError
+	if errors.As(err, &restErr) && restErr.Response != nil {
+		status := restErr.Response.StatusCode
+		switch {
+		case status == http.StatusTooManyRequests:
+			return retryAfter(restErr, p.backoff(attempt)), true
+		case status >= http.StatusInternalServerError:
+			return p.backoff(attempt), true
+		default:
+			return 0, false
+		}
+	}
+
+	if transportError(err) {
+		return p.backoff(attempt), true
+	}
+	// Other errors, such as those raised by discordgo before sending the request or after failing to parse a
+	// successful response, would fail again or duplicate a request Discord has already accepted.
+	return 0, false
+}
+
+// transportError reports whether the error shows that no response was received from Discord, or that discordgo
+// gave up after repeated bad gateway responses, so the request may be retried.
+func transportError(err error) bool {
+	var netErr net.Error
+	var urlErr *url.Error
+	switch {
+	case errors.As(err, &netErr), errors.As(err, &urlErr), errors.Is(err, io.ErrUnexpectedEOF):
+		return true
+	default:
+		return strings.HasPrefix(err.Error(), "Exceeded Max retries HTTP 502")
+	}
+}
+
+// backoff returns the exponential backoff delay, with jitter, before the attempt following the given attempt.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || delay < p.MaxBackoff); i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	if delay <= 0 {
+		return 0
+	}
+	// Wait for between half and all of the delay, so concurrent clients do not retry in lockstep.
+	half := delay / 2
+	return half + rand.N(delay-half+1)
+}
+
+// retryAfter returns the delay Discord requested in a rate limit response, or the fallback if there is none.
+func retryAfter(restErr *discordgo.RESTError, fallback time.Duration) time.Duration {
+	var rl discordgo.TooManyRequests
+	if err := discordgo.Unmarshal(restErr.ResponseBody, &rl); err == nil && rl.RetryAfter > 0 {
+		return rl.RetryAfter
+	}
+	return fallback
+}
+
+// bufferFiles replaces the readers of any files that cannot be rewound with in-memory copies, so the files can be
+// sent again when a request is retried.
+func bufferFiles(files []*discordgo.File) error {
+	for _, f := range files {
+		if f == nil || f.Reader == nil {
+			continue
+		}
+		if _, ok := f.Reader.(io.Seeker); ok {
+			continue
+		}
+		data, err := io.ReadAll(f.Reader)
+		if err != nil {
+			return err
+		}
+		f.Reader = bytes.NewReader(data)
+	}
+	return nil
+}
+
+// rewindFiles seeks the readers of the files back to the start.
+func rewindFiles(files []*discordgo.File) error {
+	for _, f := range files {
+		if f == nil {
+			continue
+		}
+		if seeker, ok := f.Reader.(io.Seeker); ok {
+			if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,342 @@
+package disgomsg
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// rewriteTransport sends every request to the target server, regardless of the host in the request URL.
+type rewriteTransport struct {
+	target *url.URL
+}
+
+// RoundTrip rewrites the request URL to the target server and sends the request.
+func (t rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// newFakeDiscord starts a local HTTP server standing in for Discord and returns a session that sends all requests
+// to it.
+func newFakeDiscord(t *testing.T, handler http.HandlerFunc) *discordgo.Session {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	target, _ := url.Parse(server.URL)
+
+	s, err := discordgo.New("Bot token")
+	if err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+	s.Client = &http.Client{Transport: rewriteTransport{target: target}}
+	return s
+}
+
+// noSleep replaces the retry sleep for the duration of the test, recording the requested delays.
+func noSleep(t *testing.T) *[]time.Duration {
+	t.Helper()
+	var delays []time.Duration
+	previous := sleep
+	sleep = func(ctx context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return ctx.Err()
+	}
+	t.Cleanup(func() { sleep = previous })
+	return &delays
+}
+
+// respond writes the status and body to the response.
+func respond(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = io.WriteString(w, body)
+}
+
+func TestRetryPolicyRetriesRateLimitsAndServerErrors(t *testing.T) {
+	delays := noSleep(t)
+	var calls atomic.Int32
+	s := newFakeDiscord(t, func(w http.ResponseWriter, r *http.Request) {
+		switch calls.Add(1) {
+		case 1:
+			respond(w, http.StatusTooManyRequests, `{"message": "You are being rate limited.", "retry_after": 1.5, "global": false}`)
+		case 2:
+			respond(w, http.StatusInternalServerError, `{"message": "Internal Server Error", "code": 0}`)
+		default:
+			respond(w, http.StatusOK, `{"id": "message-1", "channel_id": "channel-1"}`)
+		}
+	})
+
+	var attempts []RetryAttempt
+	policy := &RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 100 * time.Millisecond,
+		OnAttempt:      func(a RetryAttempt) { attempts = append(attempts, a) },
+	}
+	msg := NewMessage(WithContent("hello"), WithRetryPolicy(policy))
+	messageID, err := msg.Send(s, "channel-1")
+	if err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if messageID != "message-1" {
+		t.Errorf("Expected message-1, got %q", messageID)
+	}
+	if calls.Load() != 3 || len(attempts) != 3 {
+		t.Fatalf("Expected 3 attempts, got %d calls and %d hooks", calls.Load(), len(attempts))
+	}
+	if !attempts[0].Retry || attempts[0].Delay != 1500*time.Millisecond {
+		t.Errorf("Expected rate limit to be retried after 1.5s, got %+v", attempts[0])
+	}
+	if !attempts[1].Retry || attempts[1].Delay < 100*time.Millisecond || attempts[1].Delay > 200*time.Millisecond {
+		t.Errorf("Expected server error to be retried with backoff, got %+v", attempts[1])
+	}
+	if attempts[2].Err != nil || attempts[2].Retry || attempts[2].Op != "send message" || attempts[2].Attempt != 3 {
+		t.Errorf("Unexpected final attempt %+v", attempts[2])
+	}
+	if len(*delays) != 2 {
+		t.Errorf("Expected 2 delays, got %v", *delays)
+	}
+}
+
+func TestRetryPolicyDoesNotRetryClientErrors(t *testing.T) {
+	noSleep(t)
+	var calls atomic.Int32
+	s := newFakeDiscord(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		respond(w, http.StatusBadRequest, `{"message": "Invalid Form Body", "code": 50035, "errors": {"content": {"_errors": [{"code": "BASE_TYPE_MAX_LENGTH", "message": "Too long"}]}}}`)
+	})
+
+	msg := NewMessage(WithContent("hello"), WithRetryPolicy(DefaultRetryPolicy()))
+	_, err := msg.Send(s, "channel-1")
+	if !errors.Is(err, ErrInvalidFormBody) {
+		t.Fatalf("Expected ErrInvalidFormBody, got %v", err)
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && (len(apiErr.FieldErrors) != 1 || apiErr.FieldErrors[0].Field != "content") {
+		t.Errorf("Expected content field error, got %+v", apiErr.FieldErrors)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("Expected a single attempt, got %d", calls.Load())
+	}
+}
+
+func TestRetryPolicyDoesNotRetryUnparsedResponses(t *testing.T) {
+	noSleep(t)
+	var calls atomic.Int32
+	s := newFakeDiscord(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		respond(w, http.StatusOK, `{"id": `)
+	})
+
+	msg := NewMessage(WithContent("hello"), WithRetryPolicy(DefaultRetryPolicy()))
+	if _, err := msg.Send(s, "channel-1"); !errors.Is(err, discordgo.ErrJSONUnmarshal) {
+		t.Fatalf("Expected ErrJSONUnmarshal, got %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("Expected a single attempt, got %d", calls.Load())
+	}
+}
+
+func TestRetryPolicyDoesNotRetryRequestErrors(t *testing.T) {
+	delays := noSleep(t)
+	var calls atomic.Int32
+	s := newFakeDiscord(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		respond(w, http.StatusOK, `{"id": "message-1", "channel_id": "channel-1"}`)
+	})
+
+	msg := NewMessage(WithStickerIDs([]string{"1", "2", "3", "4"}), WithRetryPolicy(DefaultRetryPolicy()))
+	if _, err := msg.Send(s, "channel-1"); err == nil {
+		t.Fatal("Expected an error for too many stickers")
+	}
+	if calls.Load() != 0 || len(*delays) != 0 {
+		t.Errorf("Expected no requests or retries, got %d requests and %d retries", calls.Load(), len(*delays))
+	}
+}
+
+func TestRetryPolicyGivesUp(t *testing.T) {
+	noSleep(t)
+	var calls atomic.Int32
+	s := newFakeDiscord(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		respond(w, http.StatusTooManyRequests, `{"message": "You are being rate limited.", "retry_after": 0.01, "global": false}`)
+	})
+
+	msg := NewMessage(WithRetryPolicy(&RetryPolicy{MaxAttempts: 3}))
+	err := msg.WithChannelID("channel-1").WithMessageID("message-1").Delete(s)
+	if !errors.Is(err, ErrRateLimited) {
+		t.Errorf("Expected ErrRateLimited, got %v", err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("Expected 3 attempts, got %d", calls.Load())
+	}
+}
+
+func TestRetryPolicyRetriesNetworkErrors(t *testing.T) {
+	noSleep(t)
+	var calls atomic.Int32
+	s := newFakeDiscord(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			// Drop the connection without responding
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			_ = conn.Close()
+			return
+		}
+		respond(w, http.StatusOK, `{"id": "message-1", "channel_id": "channel-1"}`)
+	})
+
+	msg := NewMessage(WithContent("hello"), WithRetryPolicy(&RetryPolicy{MaxAttempts: 2}))
+	if _, err := msg.Send(s, "channel-1"); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("Expected 2 attempts, got %d", calls.Load())
+	}
+}
+
+func TestRetryPolicyResendsFiles(t *testing.T) {
+	noSleep(t)
+	var calls atomic.Int32
+	var bodies []string
+	s := newFakeDiscord(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if calls.Add(1) == 1 {
+			respond(w, http.StatusServiceUnavailable, `{"message": "Service Unavailable"}`)
+			return
+		}
+		respond(w, http.StatusOK, `{"id": "message-1", "channel_id": "channel-1"}`)
+	})
+
+	files := []*discordgo.File{{Name: "report.txt", ContentType: "text/plain", Reader: io.NopCloser(strings.NewReader("file contents"))}}
+	msg := NewMessage(WithFiles(files), WithRetryPolicy(&RetryPolicy{MaxAttempts: 2}))
+	if _, err := msg.Send(s, "channel-1"); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if len(bodies) != 2 {
+		t.Fatalf("Expected 2 requests, got %d", len(bodies))
+	}
+	for i, body := range bodies {
+		if !strings.Contains(body, "file contents") {
+			t.Errorf("Expected request %d to contain the file contents", i)
+		}
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := &RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond}
+	tests := []struct {
+		attempt  int
+		min, max time.Duration
+	}{
+		{1, 50 * time.Millisecond, 100 * time.Millisecond},
+		{2, 100 * time.Millisecond, 200 * time.Millisecond},
+		{3, 150 * time.Millisecond, 300 * time.Millisecond},
+		{10, 150 * time.Millisecond, 300 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		for n := 0; n < 20; n++ {
+			if d := p.backoff(tt.attempt); d < tt.min || d > tt.max {
+				t.Errorf("Expected backoff for attempt %d between %v and %v, got %v", tt.attempt, tt.min, tt.max, d)
+			}
+		}
+	}
+}
+
+func TestNoRetryPolicy(t *testing.T) {
+	sendErr := errors.New("connection refused")
+	rec := NewRecorder()
+	rec.Errors = map[string]error{"ChannelMessageSendComplex": sendErr}
+	if _, err := NewMessage(WithContent("hello")).Send(rec, "channel-1"); !errors.Is(err, sendErr) {
+		t.Errorf("Expected %v, got %v", sendErr, err)
+	}
+	if len(rec.Requests()) != 1 {
+		t.Errorf("Expected a single attempt without a retry policy, got %d", len(rec.Requests()))
+	}
+}
+
+func TestCanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	rec := NewRecorder()
+	_, err := NewMessage(WithContent("hello")).SendContext(ctx, rec, "channel-1")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Op != "send message" {
+		t.Errorf("Expected *APIError for send message, got %v", err)
+	}
+
+	r := NewResponse(WithContent("hello"))
+	if err := r.SendContext(ctx, rec, &discordgo.Interaction{ID: "interaction-1"}); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if r.State() != InteractionStateNew {
+		t.Errorf("Expected response to remain unsent, got state %v", r.State())
+	}
+	if len(rec.Requests()) != 0 {
+		t.Errorf("Expected no requests with a canceled context, got %d", len(rec.Requests()))
+	}
+}
+
+func TestContextAbortsRetries(t *testing.T) {
+	var calls atomic.Int32
+	s := newFakeDiscord(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		respond(w, http.StatusInternalServerError, `{"message": "Internal Server Error"}`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	policy := &RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: time.Hour,
+		OnAttempt:      func(RetryAttempt) { cancel() },
+	}
+	done := make(chan error, 1)
+	go func() {
+		_, err := NewMessage(WithContent("hello"), WithRetryPolicy(policy)).SendContext(ctx, s, "channel-1")
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+		var restErr *discordgo.RESTError
+		if !errors.As(err, &restErr) {
+			t.Errorf("Expected the last attempt's error to be preserved, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Pending retry was not abandoned when the context was canceled")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("Expected a single attempt, got %d", calls.Load())
+	}
+}
+
+func TestContextDeadlineReachesRequest(t *testing.T) {
+	s := newFakeDiscord(t, func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := NewMessage().WithChannelID("channel-1").WithMessageID("message-1").DeleteContext(ctx, s)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
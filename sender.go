@@ -0,0 +1,66 @@
+package disgomsg
+
+import "github.com/bwmarrin/discordgo"
+
+// Sender is the subset of the discordgo.Session REST API used to send, edit and delete messages and interaction
+// responses. A *discordgo.Session satisfies Sender, and a Recorder may be used in its place in tests.
+type Sender interface {
+	ChannelMessageSendComplex(channelID string, data *discordgo.MessageSend, options ...discordgo.RequestOption) (*discordgo.Message, error)
+	ChannelMessageEditComplex(m *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error)
+	ChannelMessageDelete(channelID, messageID string, options ...discordgo.RequestOption) error
+	UserChannelCreate(recipientID string, options ...discordgo.RequestOption) (*discordgo.Channel, error)
+	InteractionRespond(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse, options ...discordgo.RequestOption) error
+	InteractionResponseEdit(interaction *discordgo.Interaction, newresp *discordgo.WebhookEdit, options ...discordgo.RequestOption) (*discordgo.Message, error)
+	InteractionResponseDelete(interaction *discordgo.Interaction, options ...discordgo.RequestOption) error
+}
+
+var _ Sender = (*discordgo.Session)(nil)
+
+// FollowupSender is the subset of the discordgo.Session REST API used to send, edit and delete interaction
+// follow-up messages. A *discordgo.Session satisfies FollowupSender, as does a Recorder.
+type FollowupSender interface {
+	FollowupMessageCreate(interaction *discordgo.Interaction, wait bool, data *discordgo.WebhookParams, options ...discordgo.RequestOption) (*discordgo.Message, error)
+	FollowupMessageEdit(interaction *discordgo.Interaction, messageID string, data *discordgo.WebhookEdit, options ...discordgo.RequestOption) (*discordgo.Message, error)
+	FollowupMessageDelete(interaction *discordgo.Interaction, messageID string, options ...discordgo.RequestOption) error
+}
+
+var _ FollowupSender = (*discordgo.Session)(nil)
+
+// MessageFetcher is the subset of the discordgo.Session REST API used to retrieve an existing message. A
+// *discordgo.Session satisfies MessageFetcher, as does a Recorder.
+type MessageFetcher interface {
+	ChannelMessage(channelID, messageID string, options ...discordgo.RequestOption) (*discordgo.Message, error)
+}
+
+var _ MessageFetcher = (*discordgo.Session)(nil)
+
+// WebhookSender is the subset of the discordgo.Session REST API used to send, edit and delete webhook messages. A
+// *discordgo.Session satisfies WebhookSender, as does a Recorder.
+type WebhookSender interface {
+	WebhookExecute(webhookID, token string, wait bool, data *discordgo.WebhookParams, options ...discordgo.RequestOption) (*discordgo.Message, error)
+	WebhookThreadExecute(webhookID, token string, wait bool, threadID string, data *discordgo.WebhookParams, options ...discordgo.RequestOption) (*discordgo.Message, error)
+	WebhookMessageEdit(webhookID, token, messageID string, data *discordgo.WebhookEdit, options ...discordgo.RequestOption) (*discordgo.Message, error)
+	WebhookMessageDelete(webhookID, token, messageID string, options ...discordgo.RequestOption) error
+}
+
+var _ WebhookSender = (*discordgo.Session)(nil)
+
+// ThreadSender is the subset of the discordgo.Session REST API used to start threads and post messages in them. A
+// *discordgo.Session satisfies ThreadSender, as does a Recorder.
+type ThreadSender interface {
+	Sender
+	MessageThreadStartComplex(channelID, messageID string, data *discordgo.ThreadStart, options ...discordgo.RequestOption) (*discordgo.Channel, error)
+	ThreadStartComplex(channelID string, data *discordgo.ThreadStart, options ...discordgo.RequestOption) (*discordgo.Channel, error)
+}
+
+var _ ThreadSender = (*discordgo.Session)(nil)
+
+// ForumSender is the subset of the discordgo.Session REST API used to create forum posts, resolve their tags and
+// edit or delete their starter messages. A *discordgo.Session satisfies ForumSender, as does a Recorder.
+type ForumSender interface {
+	Sender
+	Channel(channelID string, options ...discordgo.RequestOption) (*discordgo.Channel, error)
+	ForumThreadStartComplex(channelID string, threadData *discordgo.ThreadStart, messageData *discordgo.MessageSend, options ...discordgo.RequestOption) (*discordgo.Channel, error)
+}
+
+var _ ForumSender = (*discordgo.Session)(nil)
@@ -0,0 +1,823 @@
+package disgomsg
+
+import (
+	"github.com/bwmarrin/discordgo"
+)
+
+// With applies the options to the message.
+func (m *Message) With(opts ...Option) *Message {
+	for _, opt := range opts {
+		opt((*message)(m))
+	}
+	return m
+}
+
+// ClearAttachments removes all existing attachments when the message is edited. Files added with WithFiles are
+// still uploaded.
+func (m *Message) ClearAttachments() *Message {
+	return m.With(ClearAttachments())
+}
+
+// ClearComponents removes all components when the message is edited.
+func (m *Message) ClearComponents() *Message {
+	return m.With(ClearComponents())
+}
+
+// ClearContent removes the content when the message is edited.
+func (m *Message) ClearContent() *Message {
+	return m.With(ClearContent())
+}
+
+// ClearEmbeds removes all embeds when the message is edited.
+func (m *Message) ClearEmbeds() *Message {
+	return m.With(ClearEmbeds())
+}
+
+// WithAllowedMentions sets the allowed mentions for the message.
+func (m *Message) WithAllowedMentions(allowedMentions *discordgo.MessageAllowedMentions) *Message {
+	return m.With(WithAllowedMentions(allowedMentions))
+}
+
+// WithAttachments sets the attachments for the message.
+func (m *Message) WithAttachments(attachments []*discordgo.MessageAttachment) *Message {
+	return m.With(WithAttachments(attachments))
+}
+
+// WithChannelID sets the channel ID for the message.
+func (m *Message) WithChannelID(channelID string) *Message {
+	return m.With(WithChannelID(channelID))
+}
+
+// WithChoices sets the choices for the message.
+func (m *Message) WithChoices(choices []*discordgo.ApplicationCommandOptionChoice) *Message {
+	return m.With(WithChoices(choices))
+}
+
+// WithComponents sets the components for the message.
+func (m *Message) WithComponents(components []discordgo.MessageComponent) *Message {
+	return m.With(WithComponents(components))
+}
+
+// WithContent sets the content for the message.
+func (m *Message) WithContent(content string) *Message {
+	return m.With(WithContent(content))
+}
+
+// WithCustomID sets the custom ID for the message.
+func (m *Message) WithCustomID(customID string) *Message {
+	return m.With(WithCustomID(customID))
+}
+
+// WithEmbeds sets the embeds for the message.
+func (m *Message) WithEmbeds(embeds []*discordgo.MessageEmbed) *Message {
+	return m.With(WithEmbeds(embeds))
+}
+
+// WithFiles sets the files for the message.
+func (m *Message) WithFiles(files []*discordgo.File) *Message {
+	return m.With(WithFiles(files))
+}
+
+// WithFlags sets the flags for the message.
+func (m *Message) WithFlags(flags discordgo.MessageFlags) *Message {
+	return m.With(WithFlags(flags))
+}
+
+// WithFullEdit sets whether edits include the content, embeds, components and flags even if they have not changed
+// since the message was last sent or edited. Attachments and files are still only included if they have changed.
+func (m *Message) WithFullEdit(fullEdit bool) *Message {
+	return m.With(WithFullEdit(fullEdit))
+}
+
+// WithInteraction sets the interaction for the message.
+func (m *Message) WithInteraction(interaction *discordgo.Interaction) *Message {
+	return m.With(WithInteraction(interaction))
+}
+
+// WithMessageID sets the message ID for the message.
+func (m *Message) WithMessageID(messageID string) *Message {
+	return m.With(WithMessageID(messageID))
+}
+
+// WithReference sets the reference for the message.
+func (m *Message) WithReference(reference *discordgo.MessageReference) *Message {
+	return m.With(WithReference(reference))
+}
+
+// WithResponseType sets the response type for the message.
+func (m *Message) WithResponseType(responseType *discordgo.InteractionResponseType) *Message {
+	return m.With(WithResponseType(responseType))
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests for the message.
+func (m *Message) WithRetryPolicy(policy *RetryPolicy) *Message {
+	return m.With(WithRetryPolicy(policy))
+}
+
+// WithStickerIDs sets the sticker IDs for the message.
+func (m *Message) WithStickerIDs(stickerIDs []string) *Message {
+	return m.With(WithStickerIDs(stickerIDs))
+}
+
+// WithThreadID sets the thread a channel or webhook message is sent to, in place of the channel or the webhook's
+// channel.
+func (m *Message) WithThreadID(threadID string) *Message {
+	return m.With(WithThreadID(threadID))
+}
+
+// WithTitle sets the title for the message.
+func (m *Message) WithTitle(title string) *Message {
+	return m.With(WithTitle(title))
+}
+
+// WithTTS sets the tts for the message.
+func (m *Message) WithTTS(tts bool) *Message {
+	return m.With(WithTTS(tts))
+}
+
+// WithValidation sets whether the message is validated against Discord's limits before it is sent or edited.
+func (m *Message) WithValidation(validate bool) *Message {
+	return m.With(WithValidation(validate))
+}
+
+// With applies the options to the message.
+func (dm *DirectMessage) With(opts ...Option) *DirectMessage {
+	for _, opt := range opts {
+		opt((*message)(dm))
+	}
+	return dm
+}
+
+// ClearAttachments removes all existing attachments when the message is edited. Files added with WithFiles are
+// still uploaded.
+func (dm *DirectMessage) ClearAttachments() *DirectMessage {
+	return dm.With(ClearAttachments())
+}
+
+// ClearComponents removes all components when the message is edited.
+func (dm *DirectMessage) ClearComponents() *DirectMessage {
+	return dm.With(ClearComponents())
+}
+
+// ClearContent removes the content when the message is edited.
+func (dm *DirectMessage) ClearContent() *DirectMessage {
+	return dm.With(ClearContent())
+}
+
+// ClearEmbeds removes all embeds when the message is edited.
+func (dm *DirectMessage) ClearEmbeds() *DirectMessage {
+	return dm.With(ClearEmbeds())
+}
+
+// WithAllowedMentions sets the allowed mentions for the message.
+func (dm *DirectMessage) WithAllowedMentions(allowedMentions *discordgo.MessageAllowedMentions) *DirectMessage {
+	return dm.With(WithAllowedMentions(allowedMentions))
+}
+
+// WithAttachments sets the attachments for the message.
+func (dm *DirectMessage) WithAttachments(attachments []*discordgo.MessageAttachment) *DirectMessage {
+	return dm.With(WithAttachments(attachments))
+}
+
+// WithChannelID sets the channel ID for the message.
+func (dm *DirectMessage) WithChannelID(channelID string) *DirectMessage {
+	return dm.With(WithChannelID(channelID))
+}
+
+// WithChoices sets the choices for the message.
+func (dm *DirectMessage) WithChoices(choices []*discordgo.ApplicationCommandOptionChoice) *DirectMessage {
+	return dm.With(WithChoices(choices))
+}
+
+// WithComponents sets the components for the message.
+func (dm *DirectMessage) WithComponents(components []discordgo.MessageComponent) *DirectMessage {
+	return dm.With(WithComponents(components))
+}
+
+// WithContent sets the content for the message.
+func (dm *DirectMessage) WithContent(content string) *DirectMessage {
+	return dm.With(WithContent(content))
+}
+
+// WithCustomID sets the custom ID for the message.
+func (dm *DirectMessage) WithCustomID(customID string) *DirectMessage {
+	return dm.With(WithCustomID(customID))
+}
+
+// WithEmbeds sets the embeds for the message.
+func (dm *DirectMessage) WithEmbeds(embeds []*discordgo.MessageEmbed) *DirectMessage {
+	return dm.With(WithEmbeds(embeds))
+}
+
+// WithFiles sets the files for the message.
+func (dm *DirectMessage) WithFiles(files []*discordgo.File) *DirectMessage {
+	return dm.With(WithFiles(files))
+}
+
+// WithFlags sets the flags for the message.
+func (dm *DirectMessage) WithFlags(flags discordgo.MessageFlags) *DirectMessage {
+	return dm.With(WithFlags(flags))
+}
+
+// WithFullEdit sets whether edits include the content, embeds, components and flags even if they have not changed
+// since the message was last sent or edited. Attachments and files are still only included if they have changed.
+func (dm *DirectMessage) WithFullEdit(fullEdit bool) *DirectMessage {
+	return dm.With(WithFullEdit(fullEdit))
+}
+
+// WithInteraction sets the interaction for the message.
+func (dm *DirectMessage) WithInteraction(interaction *discordgo.Interaction) *DirectMessage {
+	return dm.With(WithInteraction(interaction))
+}
+
+// WithMessageID sets the message ID for the message.
+func (dm *DirectMessage) WithMessageID(messageID string) *DirectMessage {
+	return dm.With(WithMessageID(messageID))
+}
+
+// WithReference sets the reference for the message.
+func (dm *DirectMessage) WithReference(reference *discordgo.MessageReference) *DirectMessage {
+	return dm.With(WithReference(reference))
+}
+
+// WithResponseType sets the response type for the message.
+func (dm *DirectMessage) WithResponseType(responseType *discordgo.InteractionResponseType) *DirectMessage {
+	return dm.With(WithResponseType(responseType))
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests for the message.
+func (dm *DirectMessage) WithRetryPolicy(policy *RetryPolicy) *DirectMessage {
+	return dm.With(WithRetryPolicy(policy))
+}
+
+// WithStickerIDs sets the sticker IDs for the message.
+func (dm *DirectMessage) WithStickerIDs(stickerIDs []string) *DirectMessage {
+	return dm.With(WithStickerIDs(stickerIDs))
+}
+
+// WithTitle sets the title for the message.
+func (dm *DirectMessage) WithTitle(title string) *DirectMessage {
+	return dm.With(WithTitle(title))
+}
+
+// WithTTS sets the tts for the message.
+func (dm *DirectMessage) WithTTS(tts bool) *DirectMessage {
+	return dm.With(WithTTS(tts))
+}
+
+// WithValidation sets whether the message is validated against Discord's limits before it is sent or edited.
+func (dm *DirectMessage) WithValidation(validate bool) *DirectMessage {
+	return dm.With(WithValidation(validate))
+}
+
+// With applies the options to the response.
+func (r *Response) With(opts ...Option) *Response {
+	for _, opt := range opts {
+		opt((*message)(r))
+	}
+	return r
+}
+
+// ClearAttachments removes all existing attachments when the message is edited. Files added with WithFiles are
+// still uploaded.
+func (r *Response) ClearAttachments() *Response {
+	return r.With(ClearAttachments())
+}
+
+// ClearComponents removes all components when the message is edited.
+func (r *Response) ClearComponents() *Response {
+	return r.With(ClearComponents())
+}
+
+// ClearContent removes the content when the message is edited.
+func (r *Response) ClearContent() *Response {
+	return r.With(ClearContent())
+}
+
+// ClearEmbeds removes all embeds when the message is edited.
+func (r *Response) ClearEmbeds() *Response {
+	return r.With(ClearEmbeds())
+}
+
+// WithAllowedMentions sets the allowed mentions for the message.
+func (r *Response) WithAllowedMentions(allowedMentions *discordgo.MessageAllowedMentions) *Response {
+	return r.With(WithAllowedMentions(allowedMentions))
+}
+
+// WithAttachments sets the attachments for the message.
+func (r *Response) WithAttachments(attachments []*discordgo.MessageAttachment) *Response {
+	return r.With(WithAttachments(attachments))
+}
+
+// WithChannelID sets the channel ID for the message.
+func (r *Response) WithChannelID(channelID string) *Response {
+	return r.With(WithChannelID(channelID))
+}
+
+// WithChoices sets the choices for the message.
+func (r *Response) WithChoices(choices []*discordgo.ApplicationCommandOptionChoice) *Response {
+	return r.With(WithChoices(choices))
+}
+
+// WithComponents sets the components for the message.
+func (r *Response) WithComponents(components []discordgo.MessageComponent) *Response {
+	return r.With(WithComponents(components))
+}
+
+// WithContent sets the content for the message.
+func (r *Response) WithContent(content string) *Response {
+	return r.With(WithContent(content))
+}
+
+// WithCustomID sets the custom ID for the message.
+func (r *Response) WithCustomID(customID string) *Response {
+	return r.With(WithCustomID(customID))
+}
+
+// WithEmbeds sets the embeds for the message.
+func (r *Response) WithEmbeds(embeds []*discordgo.MessageEmbed) *Response {
+	return r.With(WithEmbeds(embeds))
+}
+
+// WithFiles sets the files for the message.
+func (r *Response) WithFiles(files []*discordgo.File) *Response {
+	return r.With(WithFiles(files))
+}
+
+// WithFlags sets the flags for the message.
+func (r *Response) WithFlags(flags discordgo.MessageFlags) *Response {
+	return r.With(WithFlags(flags))
+}
+
+// WithFullEdit sets whether edits include the content, embeds, components and flags even if they have not changed
+// since the message was last sent or edited. Attachments and files are still only included if they have changed.
+func (r *Response) WithFullEdit(fullEdit bool) *Response {
+	return r.With(WithFullEdit(fullEdit))
+}
+
+// WithMessageID sets the message ID for the message.
+func (r *Response) WithMessageID(messageID string) *Response {
+	return r.With(WithMessageID(messageID))
+}
+
+// WithReference sets the reference for the message.
+func (r *Response) WithReference(reference *discordgo.MessageReference) *Response {
+	return r.With(WithReference(reference))
+}
+
+// WithResponseType sets the response type for the message.
+func (r *Response) WithResponseType(responseType *discordgo.InteractionResponseType) *Response {
+	return r.With(WithResponseType(responseType))
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests for the message.
+func (r *Response) WithRetryPolicy(policy *RetryPolicy) *Response {
+	return r.With(WithRetryPolicy(policy))
+}
+
+// WithStickerIDs sets the sticker IDs for the message.
+func (r *Response) WithStickerIDs(stickerIDs []string) *Response {
+	return r.With(WithStickerIDs(stickerIDs))
+}
+
+// WithTitle sets the title for the message.
+func (r *Response) WithTitle(title string) *Response {
+	return r.With(WithTitle(title))
+}
+
+// WithTTS sets the tts for the message.
+func (r *Response) WithTTS(tts bool) *Response {
+	return r.With(WithTTS(tts))
+}
+
+// WithValidation sets whether the message is validated against Discord's limits before it is sent or edited.
+func (r *Response) WithValidation(validate bool) *Response {
+	return r.With(WithValidation(validate))
+}
+
+// With applies the options to the message.
+func (f *Followup) With(opts ...Option) *Followup {
+	for _, opt := range opts {
+		opt((*message)(f))
+	}
+	return f
+}
+
+// ClearAttachments removes all existing attachments when the message is edited. Files added with WithFiles are
+// still uploaded.
+func (f *Followup) ClearAttachments() *Followup {
+	return f.With(ClearAttachments())
+}
+
+// ClearComponents removes all components when the message is edited.
+func (f *Followup) ClearComponents() *Followup {
+	return f.With(ClearComponents())
+}
+
+// ClearContent removes the content when the message is edited.
+func (f *Followup) ClearContent() *Followup {
+	return f.With(ClearContent())
+}
+
+// ClearEmbeds removes all embeds when the message is edited.
+func (f *Followup) ClearEmbeds() *Followup {
+	return f.With(ClearEmbeds())
+}
+
+// WithAllowedMentions sets the allowed mentions for the message.
+func (f *Followup) WithAllowedMentions(allowedMentions *discordgo.MessageAllowedMentions) *Followup {
+	return f.With(WithAllowedMentions(allowedMentions))
+}
+
+// WithAttachments sets the attachments for the message.
+func (f *Followup) WithAttachments(attachments []*discordgo.MessageAttachment) *Followup {
+	return f.With(WithAttachments(attachments))
+}
+
+// WithChannelID sets the channel ID for the message.
+func (f *Followup) WithChannelID(channelID string) *Followup {
+	return f.With(WithChannelID(channelID))
+}
+
+// WithChoices sets the choices for the message.
+func (f *Followup) WithChoices(choices []*discordgo.ApplicationCommandOptionChoice) *Followup {
+	return f.With(WithChoices(choices))
+}
+
+// WithComponents sets the components for the message.
+func (f *Followup) WithComponents(components []discordgo.MessageComponent) *Followup {
+	return f.With(WithComponents(components))
+}
+
+// WithContent sets the content for the message.
+func (f *Followup) WithContent(content string) *Followup {
+	return f.With(WithContent(content))
+}
+
+// WithCustomID sets the custom ID for the message.
+func (f *Followup) WithCustomID(customID string) *Followup {
+	return f.With(WithCustomID(customID))
+}
+
+// WithEmbeds sets the embeds for the message.
+func (f *Followup) WithEmbeds(embeds []*discordgo.MessageEmbed) *Followup {
+	return f.With(WithEmbeds(embeds))
+}
+
+// WithFiles sets the files for the message.
+func (f *Followup) WithFiles(files []*discordgo.File) *Followup {
+	return f.With(WithFiles(files))
+}
+
+// WithFlags sets the flags for the message.
+func (f *Followup) WithFlags(flags discordgo.MessageFlags) *Followup {
+	return f.With(WithFlags(flags))
+}
+
+// WithFullEdit sets whether edits include the content, embeds, components and flags even if they have not changed
+// since the message was last sent or edited. Attachments and files are still only included if they have changed.
+func (f *Followup) WithFullEdit(fullEdit bool) *Followup {
+	return f.With(WithFullEdit(fullEdit))
+}
+
+// WithInteraction sets the interaction for the message.
+func (f *Followup) WithInteraction(interaction *discordgo.Interaction) *Followup {
+	return f.With(WithInteraction(interaction))
+}
+
+// WithMessageID sets the message ID for the message.
+func (f *Followup) WithMessageID(messageID string) *Followup {
+	return f.With(WithMessageID(messageID))
+}
+
+// WithReference sets the reference for the message.
+func (f *Followup) WithReference(reference *discordgo.MessageReference) *Followup {
+	return f.With(WithReference(reference))
+}
+
+// WithResponseType sets the response type for the message.
+func (f *Followup) WithResponseType(responseType *discordgo.InteractionResponseType) *Followup {
+	return f.With(WithResponseType(responseType))
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests for the message.
+func (f *Followup) WithRetryPolicy(policy *RetryPolicy) *Followup {
+	return f.With(WithRetryPolicy(policy))
+}
+
+// WithStickerIDs sets the sticker IDs for the message.
+func (f *Followup) WithStickerIDs(stickerIDs []string) *Followup {
+	return f.With(WithStickerIDs(stickerIDs))
+}
+
+// WithTitle sets the title for the message.
+func (f *Followup) WithTitle(title string) *Followup {
+	return f.With(WithTitle(title))
+}
+
+// WithTTS sets the tts for the message.
+func (f *Followup) WithTTS(tts bool) *Followup {
+	return f.With(WithTTS(tts))
+}
+
+// WithValidation sets whether the message is validated against Discord's limits before it is sent or edited.
+func (f *Followup) WithValidation(validate bool) *Followup {
+	return f.With(WithValidation(validate))
+}
+
+// With applies the options to the message.
+func (w *WebhookMessage) With(opts ...Option) *WebhookMessage {
+	for _, opt := range opts {
+		opt((*message)(w))
+	}
+	return w
+}
+
+// ClearAttachments removes all existing attachments when the message is edited. Files added with WithFiles are
+// still uploaded.
+func (w *WebhookMessage) ClearAttachments() *WebhookMessage {
+	return w.With(ClearAttachments())
+}
+
+// ClearComponents removes all components when the message is edited.
+func (w *WebhookMessage) ClearComponents() *WebhookMessage {
+	return w.With(ClearComponents())
+}
+
+// ClearContent removes the content when the message is edited.
+func (w *WebhookMessage) ClearContent() *WebhookMessage {
+	return w.With(ClearContent())
+}
+
+// ClearEmbeds removes all embeds when the message is edited.
+func (w *WebhookMessage) ClearEmbeds() *WebhookMessage {
+	return w.With(ClearEmbeds())
+}
+
+// WithAllowedMentions sets the allowed mentions for the message.
+func (w *WebhookMessage) WithAllowedMentions(allowedMentions *discordgo.MessageAllowedMentions) *WebhookMessage {
+	return w.With(WithAllowedMentions(allowedMentions))
+}
+
+// WithAttachments sets the attachments for the message.
+func (w *WebhookMessage) WithAttachments(attachments []*discordgo.MessageAttachment) *WebhookMessage {
+	return w.With(WithAttachments(attachments))
+}
+
+// WithAvatarURL sets the URL of the avatar shown for a webhook message, overriding the webhook's default avatar.
+func (w *WebhookMessage) WithAvatarURL(avatarURL string) *WebhookMessage {
+	return w.With(WithAvatarURL(avatarURL))
+}
+
+// WithChannelID sets the channel ID for the message.
+func (w *WebhookMessage) WithChannelID(channelID string) *WebhookMessage {
+	return w.With(WithChannelID(channelID))
+}
+
+// WithChoices sets the choices for the message.
+func (w *WebhookMessage) WithChoices(choices []*discordgo.ApplicationCommandOptionChoice) *WebhookMessage {
+	return w.With(WithChoices(choices))
+}
+
+// WithComponents sets the components for the message.
+func (w *WebhookMessage) WithComponents(components []discordgo.MessageComponent) *WebhookMessage {
+	return w.With(WithComponents(components))
+}
+
+// WithContent sets the content for the message.
+func (w *WebhookMessage) WithContent(content string) *WebhookMessage {
+	return w.With(WithContent(content))
+}
+
+// WithCustomID sets the custom ID for the message.
+func (w *WebhookMessage) WithCustomID(customID string) *WebhookMessage {
+	return w.With(WithCustomID(customID))
+}
+
+// WithEmbeds sets the embeds for the message.
+func (w *WebhookMessage) WithEmbeds(embeds []*discordgo.MessageEmbed) *WebhookMessage {
+	return w.With(WithEmbeds(embeds))
+}
+
+// WithFiles sets the files for the message.
+func (w *WebhookMessage) WithFiles(files []*discordgo.File) *WebhookMessage {
+	return w.With(WithFiles(files))
+}
+
+// WithFlags sets the flags for the message.
+func (w *WebhookMessage) WithFlags(flags discordgo.MessageFlags) *WebhookMessage {
+	return w.With(WithFlags(flags))
+}
+
+// WithFullEdit sets whether edits include the content, embeds, components and flags even if they have not changed
+// since the message was last sent or edited. Attachments and files are still only included if they have changed.
+func (w *WebhookMessage) WithFullEdit(fullEdit bool) *WebhookMessage {
+	return w.With(WithFullEdit(fullEdit))
+}
+
+// WithInteraction sets the interaction for the message.
+func (w *WebhookMessage) WithInteraction(interaction *discordgo.Interaction) *WebhookMessage {
+	return w.With(WithInteraction(interaction))
+}
+
+// WithMessageID sets the message ID for the message.
+func (w *WebhookMessage) WithMessageID(messageID string) *WebhookMessage {
+	return w.With(WithMessageID(messageID))
+}
+
+// WithReference sets the reference for the message.
+func (w *WebhookMessage) WithReference(reference *discordgo.MessageReference) *WebhookMessage {
+	return w.With(WithReference(reference))
+}
+
+// WithResponseType sets the response type for the message.
+func (w *WebhookMessage) WithResponseType(responseType *discordgo.InteractionResponseType) *WebhookMessage {
+	return w.With(WithResponseType(responseType))
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests for the message.
+func (w *WebhookMessage) WithRetryPolicy(policy *RetryPolicy) *WebhookMessage {
+	return w.With(WithRetryPolicy(policy))
+}
+
+// WithStickerIDs sets the sticker IDs for the message.
+func (w *WebhookMessage) WithStickerIDs(stickerIDs []string) *WebhookMessage {
+	return w.With(WithStickerIDs(stickerIDs))
+}
+
+// WithThreadID sets the thread a channel or webhook message is sent to, in place of the channel or the webhook's
+// channel.
+func (w *WebhookMessage) WithThreadID(threadID string) *WebhookMessage {
+	return w.With(WithThreadID(threadID))
+}
+
+// WithThreadName sets the name of the thread created for a webhook message sent to a forum or media channel.
+func (w *WebhookMessage) WithThreadName(threadName string) *WebhookMessage {
+	return w.With(WithThreadName(threadName))
+}
+
+// WithTitle sets the title for the message.
+func (w *WebhookMessage) WithTitle(title string) *WebhookMessage {
+	return w.With(WithTitle(title))
+}
+
+// WithTTS sets the tts for the message.
+func (w *WebhookMessage) WithTTS(tts bool) *WebhookMessage {
+	return w.With(WithTTS(tts))
+}
+
+// WithUsername sets the username shown for a webhook message, overriding the webhook's default name.
+func (w *WebhookMessage) WithUsername(username string) *WebhookMessage {
+	return w.With(WithUsername(username))
+}
+
+// WithValidation sets whether the message is validated against Discord's limits before it is sent or edited.
+func (w *WebhookMessage) WithValidation(validate bool) *WebhookMessage {
+	return w.With(WithValidation(validate))
+}
+
+// WithWebhook sets the ID and token of the webhook used to send, edit and delete a webhook message.
+func (w *WebhookMessage) WithWebhook(webhookID, token string) *WebhookMessage {
+	return w.With(WithWebhook(webhookID, token))
+}
+
+// With applies the options to the post.
+func (p *ForumPost) With(opts ...Option) *ForumPost {
+	for _, opt := range opts {
+		opt((*message)(p))
+	}
+	return p
+}
+
+// ClearAttachments removes all existing attachments when the message is edited. Files added with WithFiles are
+// still uploaded.
+func (p *ForumPost) ClearAttachments() *ForumPost {
+	return p.With(ClearAttachments())
+}
+
+// ClearComponents removes all components when the message is edited.
+func (p *ForumPost) ClearComponents() *ForumPost {
+	return p.With(ClearComponents())
+}
+
+// ClearContent removes the content when the message is edited.
+func (p *ForumPost) ClearContent() *ForumPost {
+	return p.With(ClearContent())
+}
+
+// ClearEmbeds removes all embeds when the message is edited.
+func (p *ForumPost) ClearEmbeds() *ForumPost {
+	return p.With(ClearEmbeds())
+}
+
+// WithAllowedMentions sets the allowed mentions for the message.
+func (p *ForumPost) WithAllowedMentions(allowedMentions *discordgo.MessageAllowedMentions) *ForumPost {
+	return p.With(WithAllowedMentions(allowedMentions))
+}
+
+// WithAttachments sets the attachments for the message.
+func (p *ForumPost) WithAttachments(attachments []*discordgo.MessageAttachment) *ForumPost {
+	return p.With(WithAttachments(attachments))
+}
+
+// WithChannelID sets the channel ID for the message.
+func (p *ForumPost) WithChannelID(channelID string) *ForumPost {
+	return p.With(WithChannelID(channelID))
+}
+
+// WithChoices sets the choices for the message.
+func (p *ForumPost) WithChoices(choices []*discordgo.ApplicationCommandOptionChoice) *ForumPost {
+	return p.With(WithChoices(choices))
+}
+
+// WithComponents sets the components for the message.
+func (p *ForumPost) WithComponents(components []discordgo.MessageComponent) *ForumPost {
+	return p.With(WithComponents(components))
+}
+
+// WithContent sets the content for the message.
+func (p *ForumPost) WithContent(content string) *ForumPost {
+	return p.With(WithContent(content))
+}
+
+// WithCustomID sets the custom ID for the message.
+func (p *ForumPost) WithCustomID(customID string) *ForumPost {
+	return p.With(WithCustomID(customID))
+}
+
+// WithEmbeds sets the embeds for the message.
+func (p *ForumPost) WithEmbeds(embeds []*discordgo.MessageEmbed) *ForumPost {
+	return p.With(WithEmbeds(embeds))
+}
+
+// WithFiles sets the files for the message.
+func (p *ForumPost) WithFiles(files []*discordgo.File) *ForumPost {
+	return p.With(WithFiles(files))
+}
+
+// WithFlags sets the flags for the message.
+func (p *ForumPost) WithFlags(flags discordgo.MessageFlags) *ForumPost {
+	return p.With(WithFlags(flags))
+}
+
+// WithFullEdit sets whether edits include the content, embeds, components and flags even if they have not changed
+// since the message was last sent or edited. Attachments and files are still only included if they have changed.
+func (p *ForumPost) WithFullEdit(fullEdit bool) *ForumPost {
+	return p.With(WithFullEdit(fullEdit))
+}
+
+// WithInteraction sets the interaction for the message.
+func (p *ForumPost) WithInteraction(interaction *discordgo.Interaction) *ForumPost {
+	return p.With(WithInteraction(interaction))
+}
+
+// WithMessageID sets the message ID for the message.
+func (p *ForumPost) WithMessageID(messageID string) *ForumPost {
+	return p.With(WithMessageID(messageID))
+}
+
+// WithReference sets the reference for the message.
+func (p *ForumPost) WithReference(reference *discordgo.MessageReference) *ForumPost {
+	return p.With(WithReference(reference))
+}
+
+// WithResponseType sets the response type for the message.
+func (p *ForumPost) WithResponseType(responseType *discordgo.InteractionResponseType) *ForumPost {
+	return p.With(WithResponseType(responseType))
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests for the message.
+func (p *ForumPost) WithRetryPolicy(policy *RetryPolicy) *ForumPost {
+	return p.With(WithRetryPolicy(policy))
+}
+
+// WithStickerIDs sets the sticker IDs for the message.
+func (p *ForumPost) WithStickerIDs(stickerIDs []string) *ForumPost {
+	return p.With(WithStickerIDs(stickerIDs))
+}
+
+// WithTagIDs sets the IDs of the tags applied to a forum post.
+func (p *ForumPost) WithTagIDs(tagIDs []string) *ForumPost {
+	return p.With(WithTagIDs(tagIDs))
+}
+
+// WithTagNames sets the names of the tags applied to a forum post. The names are resolved to tag IDs, ignoring
+// case, using the forum channel's available tags when the post is sent.
+func (p *ForumPost) WithTagNames(tagNames []string) *ForumPost {
+	return p.With(WithTagNames(tagNames))
+}
+
+// WithTitle sets the title for the message.
+func (p *ForumPost) WithTitle(title string) *ForumPost {
+	return p.With(WithTitle(title))
+}
+
+// WithTTS sets the tts for the message.
+func (p *ForumPost) WithTTS(tts bool) *ForumPost {
+	return p.With(WithTTS(tts))
+}
+
+// WithValidation sets whether the message is validated against Discord's limits before it is sent or edited.
+func (p *ForumPost) WithValidation(validate bool) *ForumPost {
+	return p.With(WithValidation(validate))
+}
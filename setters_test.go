@@ -0,0 +1,406 @@
+package disgomsg
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// setterValues are the values applied by every setter in the parity tests.
+var setterValues = struct {
+	allowedMentions *discordgo.MessageAllowedMentions
+	attachments     []*discordgo.MessageAttachment
+	choices         []*discordgo.ApplicationCommandOptionChoice
+	components      []discordgo.MessageComponent
+	embeds          []*discordgo.MessageEmbed
+	files           []*discordgo.File
+	interaction     *discordgo.Interaction
+	reference       *discordgo.MessageReference
+	responseType    *discordgo.InteractionResponseType
+	retryPolicy     *RetryPolicy
+}{
+	allowedMentions: &discordgo.MessageAllowedMentions{Users: []string{"user-1"}},
+	attachments:     []*discordgo.MessageAttachment{{ID: "attachment-1"}},
+	choices:         []*discordgo.ApplicationCommandOptionChoice{{Name: "one", Value: 1}},
+	components:      []discordgo.MessageComponent{discordgo.ActionsRow{}},
+	embeds:          []*discordgo.MessageEmbed{{Title: "Title"}},
+	files:           []*discordgo.File{{Name: "report.txt"}},
+	interaction:     &discordgo.Interaction{ID: "interaction-1"},
+	reference:       &discordgo.MessageReference{MessageID: "message-0"},
+	responseType:    new(discordgo.InteractionResponseType),
+	retryPolicy:     &RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Second},
+}
+
+// allOptions returns an option for every setter shared by all message types, using the setter values.
+func allOptions() []Option {
+	v := setterValues
+	return []Option{
+		WithAllowedMentions(v.allowedMentions),
+		WithAttachments(v.attachments),
+		WithChannelID("channel-1"),
+		WithChoices(v.choices),
+		WithComponents(v.components),
+		WithContent("hello"),
+		WithCustomID("custom-1"),
+		WithEmbeds(v.embeds),
+		WithFiles(v.files),
+		WithFlags(discordgo.MessageFlagsSuppressEmbeds),
+		WithFullEdit(true),
+		WithInteraction(v.interaction),
+		WithMessageID("message-1"),
+		WithReference(v.reference),
+		WithResponseType(v.responseType),
+		WithRetryPolicy(v.retryPolicy),
+		WithStickerIDs([]string{"sticker-1"}),
+		WithTitle("title"),
+		WithTTS(true),
+		WithValidation(true),
+	}
+}
+
+func TestSetterParity(t *testing.T) {
+	v := setterValues
+	tests := []struct {
+		name    string
+		fluent  func() *message
+		with    func(opts ...Option) *message
+		options func(opts ...Option) *message
+		extra   []Option // Options for the setters specific to the type.
+	}{
+		{
+			name: "Message",
+			fluent: func() *message {
+				return (*message)(NewMessage().WithAllowedMentions(v.allowedMentions).WithAttachments(v.attachments).
+					WithChannelID("channel-1").WithChoices(v.choices).WithComponents(v.components).WithContent("hello").
+					WithCustomID("custom-1").WithEmbeds(v.embeds).WithFiles(v.files).
+					WithFlags(discordgo.MessageFlagsSuppressEmbeds).WithFullEdit(true).WithInteraction(v.interaction).
+					WithMessageID("message-1").WithReference(v.reference).WithResponseType(v.responseType).
+					WithRetryPolicy(v.retryPolicy).WithStickerIDs([]string{"sticker-1"}).WithTitle("title").
+					WithTTS(true).WithValidation(true).WithThreadID("thread-1"))
+			},
+			with:    func(opts ...Option) *message { return (*message)(NewMessage().With(opts...)) },
+			extra:   []Option{WithThreadID("thread-1")},
+			options: func(opts ...Option) *message { return (*message)(NewMessage(opts...)) },
+		},
+		{
+			name: "DirectMessage",
+			fluent: func() *message {
+				return (*message)(NewDirectMessage().WithAllowedMentions(v.allowedMentions).WithAttachments(v.attachments).
+					WithChannelID("channel-1").WithChoices(v.choices).WithComponents(v.components).WithContent("hello").
+					WithCustomID("custom-1").WithEmbeds(v.embeds).WithFiles(v.files).
+					WithFlags(discordgo.MessageFlagsSuppressEmbeds).WithFullEdit(true).WithInteraction(v.interaction).
+					WithMessageID("message-1").WithReference(v.reference).WithResponseType(v.responseType).
+					WithRetryPolicy(v.retryPolicy).WithStickerIDs([]string{"sticker-1"}).WithTitle("title").
+					WithTTS(true).WithValidation(true))
+			},
+			with:    func(opts ...Option) *message { return (*message)(NewDirectMessage().With(opts...)) },
+			options: func(opts ...Option) *message { return (*message)(NewDirectMessage(opts...)) },
+		},
+		{
+			name: "Response",
+			fluent: func() *message {
+				return (*message)(NewResponse().WithAllowedMentions(v.allowedMentions).WithAttachments(v.attachments).
+					WithChannelID("channel-1").WithChoices(v.choices).WithComponents(v.components).WithContent("hello").
+					WithCustomID("custom-1").WithEmbeds(v.embeds).WithFiles(v.files).
+					WithFlags(discordgo.MessageFlagsSuppressEmbeds).WithFullEdit(true).WithInteraction(v.interaction).
+					WithMessageID("message-1").WithReference(v.reference).WithResponseType(v.responseType).
+					WithRetryPolicy(v.retryPolicy).WithStickerIDs([]string{"sticker-1"}).WithTitle("title").
+					WithTTS(true).WithValidation(true))
+			},
+			with:    func(opts ...Option) *message { return (*message)(NewResponse().With(opts...)) },
+			options: func(opts ...Option) *message { return (*message)(NewResponse(opts...)) },
+		},
+		{
+			name: "Followup",
+			fluent: func() *message {
+				return (*message)(NewFollowup().WithAllowedMentions(v.allowedMentions).WithAttachments(v.attachments).
+					WithChannelID("channel-1").WithChoices(v.choices).WithComponents(v.components).WithContent("hello").
+					WithCustomID("custom-1").WithEmbeds(v.embeds).WithFiles(v.files).
+					WithFlags(discordgo.MessageFlagsSuppressEmbeds).WithFullEdit(true).WithInteraction(v.interaction).
+					WithMessageID("message-1").WithReference(v.reference).WithResponseType(v.responseType).
+					WithRetryPolicy(v.retryPolicy).WithStickerIDs([]string{"sticker-1"}).WithTitle("title").
+					WithTTS(true).WithValidation(true))
+			},
+			with:    func(opts ...Option) *message { return (*message)(NewFollowup().With(opts...)) },
+			options: func(opts ...Option) *message { return (*message)(NewFollowup(opts...)) },
+		},
+		{
+			name: "WebhookMessage",
+			fluent: func() *message {
+				return (*message)(NewWebhookMessage().WithAllowedMentions(v.allowedMentions).WithAttachments(v.attachments).
+					WithChannelID("channel-1").WithChoices(v.choices).WithComponents(v.components).WithContent("hello").
+					WithCustomID("custom-1").WithEmbeds(v.embeds).WithFiles(v.files).
+					WithFlags(discordgo.MessageFlagsSuppressEmbeds).WithFullEdit(true).WithInteraction(v.interaction).
+					WithMessageID("message-1").WithReference(v.reference).WithResponseType(v.responseType).
+					WithRetryPolicy(v.retryPolicy).WithStickerIDs([]string{"sticker-1"}).WithTitle("title").
+					WithTTS(true).WithValidation(true).WithAvatarURL("https://example.com/avatar.png").WithThreadID("thread-1").WithThreadName("thread").
+					WithUsername("username").WithWebhook("webhook-1", "token"))
+			},
+			with: func(opts ...Option) *message { return (*message)(NewWebhookMessage().With(opts...)) },
+			extra: []Option{
+				WithAvatarURL("https://example.com/avatar.png"),
+				WithThreadID("thread-1"),
+				WithThreadName("thread"),
+				WithUsername("username"),
+				WithWebhook("webhook-1", "token"),
+			},
+			options: func(opts ...Option) *message { return (*message)(NewWebhookMessage(opts...)) },
+		},
+		{
+			name: "ForumPost",
+			fluent: func() *message {
+				return (*message)(NewForumPost().WithAllowedMentions(v.allowedMentions).WithAttachments(v.attachments).
+					WithChannelID("channel-1").WithChoices(v.choices).WithComponents(v.components).WithContent("hello").
+					WithCustomID("custom-1").WithEmbeds(v.embeds).WithFiles(v.files).
+					WithFlags(discordgo.MessageFlagsSuppressEmbeds).WithFullEdit(true).WithInteraction(v.interaction).
+					WithMessageID("message-1").WithReference(v.reference).WithResponseType(v.responseType).
+					WithRetryPolicy(v.retryPolicy).WithStickerIDs([]string{"sticker-1"}).WithTitle("title").
+					WithTTS(true).WithValidation(true).WithTagIDs([]string{"tag-1"}).WithTagNames([]string{"news"}))
+			},
+			with:    func(opts ...Option) *message { return (*message)(NewForumPost().With(opts...)) },
+			extra:   []Option{WithTagIDs([]string{"tag-1"}), WithTagNames([]string{"news"})},
+			options: func(opts ...Option) *message { return (*message)(NewForumPost(opts...)) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append(allOptions(), tt.extra...)
+			want := tt.options(opts...)
+			if got := tt.fluent(); !reflect.DeepEqual(got, want) {
+				t.Errorf("Expected fluent setters to match options:\n got %+v\nwant %+v", got, want)
+			}
+			if with := tt.with(opts...); !reflect.DeepEqual(with, want) {
+				t.Errorf("Expected With to match options:\n got %+v\nwant %+v", with, want)
+			}
+		})
+	}
+}
+
+func TestClearParity(t *testing.T) {
+	tests := []struct {
+		name  string
+		clear func() *message
+	}{
+		{"Message", func() *message {
+			return (*message)(NewMessage().ClearContent().ClearEmbeds().ClearComponents().ClearAttachments())
+		}},
+		{"DirectMessage", func() *message {
+			return (*message)(NewDirectMessage().ClearContent().ClearEmbeds().ClearComponents().ClearAttachments())
+		}},
+		{"Response", func() *message {
+			return (*message)(NewResponse().ClearContent().ClearEmbeds().ClearComponents().ClearAttachments())
+		}},
+		{"Followup", func() *message {
+			return (*message)(NewFollowup().ClearContent().ClearEmbeds().ClearComponents().ClearAttachments())
+		}},
+		{"WebhookMessage", func() *message {
+			return (*message)(NewWebhookMessage().ClearContent().ClearEmbeds().ClearComponents().ClearAttachments())
+		}},
+		{"ForumPost", func() *message {
+			return (*message)(NewForumPost().ClearContent().ClearEmbeds().ClearComponents().ClearAttachments())
+		}},
+	}
+	want := fieldContent | fieldEmbeds | fieldComponents | fieldAttachments
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := tt.clear()
+			if m.changed != want {
+				t.Errorf("Expected cleared fields %b to be marked as changed, got %b", want, m.changed)
+			}
+			if m.content != "" || len(m.embeds) != 0 || len(m.components) != 0 || len(m.attachments) != 0 {
+				t.Errorf("Expected fields to be cleared, got %+v", m)
+			}
+		})
+	}
+}
+
+// lifecycleTarget adapts one of the message types so the same send, edit and delete scenarios can be run
+// against each of them.
+type lifecycleTarget struct {
+	name   string
+	new    func(opts ...Option) *message
+	send   func(m *message, rec *Recorder) error
+	edit   func(m *message, rec *Recorder) error
+	delete func(m *message, rec *Recorder) error
+}
+
+// lifecycleTargets returns the message, direct message, response, follow-up, webhook message and forum post
+// adapters.
+func lifecycleTargets() []lifecycleTarget {
+	interaction := &discordgo.Interaction{ID: "interaction-1"}
+	return []lifecycleTarget{
+		{
+			name: "Message",
+			new:  func(opts ...Option) *message { return (*message)(NewMessage(opts...)) },
+			send: func(m *message, rec *Recorder) error {
+				_, err := (*Message)(m).Send(rec, "channel-1")
+				return err
+			},
+			edit:   func(m *message, rec *Recorder) error { return (*Message)(m).Edit(rec) },
+			delete: func(m *message, rec *Recorder) error { return (*Message)(m).Delete(rec) },
+		},
+		{
+			name: "DirectMessage",
+			new:  func(opts ...Option) *message { return (*message)(NewDirectMessage(opts...)) },
+			send: func(m *message, rec *Recorder) error {
+				_, err := (*DirectMessage)(m).Send(rec, "member-1")
+				return err
+			},
+			edit:   func(m *message, rec *Recorder) error { return (*DirectMessage)(m).Edit(rec) },
+			delete: func(m *message, rec *Recorder) error { return (*DirectMessage)(m).Delete(rec) },
+		},
+		{
+			name:   "Response",
+			new:    func(opts ...Option) *message { return (*message)(NewResponse(opts...)) },
+			send:   func(m *message, rec *Recorder) error { return (*Response)(m).Send(rec, interaction) },
+			edit:   func(m *message, rec *Recorder) error { return (*Response)(m).Edit(rec) },
+			delete: func(m *message, rec *Recorder) error { return (*Response)(m).Delete(rec) },
+		},
+		{
+			name: "Followup",
+			new:  func(opts ...Option) *message { return (*message)(NewFollowup(opts...)) },
+			send: func(m *message, rec *Recorder) error {
+				_, err := (*Followup)(m).Send(rec, interaction)
+				return err
+			},
+			edit:   func(m *message, rec *Recorder) error { return (*Followup)(m).Edit(rec) },
+			delete: func(m *message, rec *Recorder) error { return (*Followup)(m).Delete(rec) },
+		},
+		{
+			name: "Followup",
+			new:  func(opts ...Option) *message { return (*message)(NewFollowup(opts...)) },
+			send: func(m *message, rec *Recorder) error {
+				_, err := (*Followup)(m).Send(rec, interaction)
+				return err
+			},
+			edit:   func(m *message, rec *Recorder) error { return (*Followup)(m).Edit(rec) },
+			delete: func(m *message, rec *Recorder) error { return (*Followup)(m).Delete(rec) },
+		},
+		{
+			name: "WebhookMessage",
+			new:  func(opts ...Option) *message { return (*message)(NewWebhookMessage(opts...)) },
+			send: func(m *message, rec *Recorder) error {
+				_, err := (*WebhookMessage)(m).Send(rec, "webhook-1", "token")
+				return err
+			},
+			edit:   func(m *message, rec *Recorder) error { return (*WebhookMessage)(m).Edit(rec) },
+			delete: func(m *message, rec *Recorder) error { return (*WebhookMessage)(m).Delete(rec) },
+		},
+		{
+			name: "ForumPost",
+			new: func(opts ...Option) *message {
+				return (*message)(NewForumPost(append([]Option{WithTitle("post")}, opts...)...))
+			},
+			send: func(m *message, rec *Recorder) error {
+				_, _, err := (*ForumPost)(m).Send(rec, "forum-1")
+				return err
+			},
+			edit:   func(m *message, rec *Recorder) error { return (*ForumPost)(m).Edit(rec) },
+			delete: func(m *message, rec *Recorder) error { return (*ForumPost)(m).Delete(rec) },
+		},
+	}
+}
+
+// editPayload returns the content, embeds and allowed mentions sent in an edit request.
+func editPayload(req RecordedRequest) (*string, *[]*discordgo.MessageEmbed, *discordgo.MessageAllowedMentions) {
+	if req.MessageEdit != nil {
+		return req.MessageEdit.Content, req.MessageEdit.Embeds, req.MessageEdit.AllowedMentions
+	}
+	if req.WebhookEdit != nil {
+		return req.WebhookEdit.Content, req.WebhookEdit.Embeds, req.WebhookEdit.AllowedMentions
+	}
+	return nil, nil, nil
+}
+
+func TestLifecycleParity(t *testing.T) {
+	for _, tt := range lifecycleTargets() {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := NewRecorder()
+			mentions := &discordgo.MessageAllowedMentions{Users: []string{"user-1"}}
+			m := tt.new(WithContent("hello"), WithEmbeds([]*discordgo.MessageEmbed{{Title: "Report"}}), WithAllowedMentions(mentions))
+
+			if err := tt.send(m, rec); err != nil {
+				t.Fatalf("Send returned error: %v", err)
+			}
+			if m.changed != 0 {
+				t.Errorf("Expected no changed fields after sending, got %b", m.changed)
+			}
+
+			WithContent("updated")(m)
+			if err := tt.edit(m, rec); err != nil {
+				t.Fatalf("Edit returned error: %v", err)
+			}
+			content, embeds, allowedMentions := editPayload(last(rec))
+			if content == nil || *content != "updated" {
+				t.Errorf("Expected updated content to be sent, got %v", content)
+			}
+			if embeds != nil {
+				t.Errorf("Expected unchanged embeds to be omitted, got %v", *embeds)
+			}
+			if allowedMentions != mentions {
+				t.Errorf("Expected allowed mentions to be sent, got %v", allowedMentions)
+			}
+
+			if err := tt.delete(m, rec); err != nil {
+				t.Fatalf("Delete returned error: %v", err)
+			}
+			if err := tt.edit(m, rec); err == nil {
+				t.Error("Expected editing a deleted message to fail")
+			}
+			if err := tt.delete(m, rec); err == nil {
+				t.Error("Expected deleting a deleted message to fail")
+			}
+		})
+	}
+}
+
+func TestLifecycleParityErrors(t *testing.T) {
+	for _, tt := range lifecycleTargets() {
+		t.Run(tt.name, func(t *testing.T) {
+			sendErr := errors.New("send failed")
+			rec := NewRecorder()
+			rec.Errors = map[string]error{
+				"ChannelMessageSendComplex": sendErr,
+				"InteractionRespond":        sendErr,
+				"FollowupMessageCreate":     sendErr,
+				"WebhookExecute":            sendErr,
+				"ForumThreadStartComplex":   sendErr,
+			}
+			m := tt.new(WithContent("hello"))
+			err := tt.send(m, rec)
+			if !errors.Is(err, sendErr) {
+				t.Fatalf("Expected %v, got %v", sendErr, err)
+			}
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Errorf("Expected *APIError, got %T", err)
+			}
+			if m.changed&fieldContent == 0 {
+				t.Error("Expected content to remain changed after a failed send")
+			}
+
+			m = tt.new(WithValidation(true), WithContent(string(make([]byte, MaxContentLength+1))))
+			if err := tt.send(m, NewRecorder()); !errors.Is(err, ErrValidation) {
+				t.Errorf("Expected ErrValidation, got %v", err)
+			}
+
+			rec = NewRecorder()
+			m = tt.new(WithValidation(true), WithContent("hello"))
+			if err := tt.send(m, rec); err != nil {
+				t.Fatalf("Send returned error: %v", err)
+			}
+			requests := len(rec.Requests())
+			WithContent(string(make([]byte, MaxContentLength+1)))(m)
+			if err := tt.edit(m, rec); !errors.Is(err, ErrValidation) {
+				t.Errorf("Expected ErrValidation on edit, got %v", err)
+			}
+			if len(rec.Requests()) != requests {
+				t.Error("Expected the invalid edit not to be sent")
+			}
+		})
+	}
+}
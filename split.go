@@ -0,0 +1,203 @@
+package disgomsg
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const codeFence = "```"
+
+// SendSplit sends the message to the specified channel, or to the thread set with WithThreadID, splitting content
+// longer than Discord's limit into multiple messages. Embeds, components, files and stickers are attached to the
+// final message only. The IDs of all messages sent are returned in order, along with any that were sent before an
+// error occurred. The message ID is set to that of the final message. ErrCannotSendEmpty is returned without
+// sending anything if the message has no content, embeds, components, files or stickers.
+func (m *Message) SendSplit(s Sender, channelID string, options ...discordgo.RequestOption) ([]string, error) {
+	return m.SendSplitContext(context.Background(), s, channelID, options...)
+}
+
+// SendSplitContext is like SendSplit, but the requests are bound to the context and any pending retries are
+// abandoned once the context is done.
+func (m *Message) SendSplitContext(ctx context.Context, s Sender, channelID string, options ...discordgo.RequestOption) ([]string, error) {
+	if !(*message)(m).hasBody() {
+		return nil, ErrCannotSendEmpty
+	}
+	if m.validate {
+		if err := (*message)(m).validateChunk(splitContent(m.content, MaxContentLength)[0]); err != nil {
+			return nil, err
+		}
+	}
+	m.channelID = channelID
+	if m.threadID != "" {
+		m.channelID = m.threadID
+	}
+	return (*message)(m).sendSplit(ctx, s, options...)
+}
+
+// SendSplit sends a direct message to the specified member, splitting content longer than Discord's limit into
+// multiple messages. Embeds, components, files and stickers are attached to the final message only. The IDs of
+// all messages sent are returned in order, along with any that were sent before an error occurred. The message
+// ID is set to that of the final message. ErrCannotSendEmpty is returned without sending anything if the message
+// has no content, embeds, components, files or stickers.
+func (dm *DirectMessage) SendSplit(s Sender, memberID string, options ...discordgo.RequestOption) ([]string, error) {
+	return dm.SendSplitContext(context.Background(), s, memberID, options...)
+}
+
+// SendSplitContext is like SendSplit, but the requests are bound to the context and any pending retries are
+// abandoned once the context is done.
+func (dm *DirectMessage) SendSplitContext(ctx context.Context, s Sender, memberID string, options ...discordgo.RequestOption) ([]string, error) {
+	if !(*message)(dm).hasBody() {
+		return nil, ErrCannotSendEmpty
+	}
+	if dm.validate {
+		if err := (*message)(dm).validateChunk(splitContent(dm.content, MaxContentLength)[0]); err != nil {
+			return nil, err
+		}
+	}
+	if err := dm.openChannel(ctx, s, memberID, options...); err != nil {
+		return nil, err
+	}
+	return (*message)(dm).sendSplit(ctx, s, options...)
+}
+
+// sendSplit sends the message content in chunks to the message's channel.
+func (m *message) sendSplit(ctx context.Context, s Sender, options ...discordgo.RequestOption) ([]string, error) {
+	chunks := splitContent(m.content, MaxContentLength)
+	messageIDs := make([]string, 0, len(chunks))
+	for i, chunk := range chunks {
+		data := &discordgo.MessageSend{
+			AllowedMentions: m.allowedMentions,
+			Content:         chunk,
+			Flags:           m.flags,
+			TTS:             m.tts,
+		}
+		if i == 0 {
+			data.Reference = m.reference
+		}
+		if i == len(chunks)-1 {
+			data.Components = m.components
+			data.Embeds = m.embeds
+			data.Files = m.files
+			data.StickerIDs = m.stickerIDs
+		}
+		var sent *discordgo.Message
+		err := m.do(ctx, "send message", options, func(options ...discordgo.RequestOption) (err error) {
+			sent, err = s.ChannelMessageSendComplex(m.channelID, data, options...)
+			return err
+		})
+		if err != nil {
+			return messageIDs, err
+		}
+		messageIDs = append(messageIDs, sent.ID)
+		m.messageID = sent.ID
+	}
+	m.markSent()
+
+	return messageIDs, nil
+}
+
+// validateChunk validates the message as it would be sent with the given chunk of content, since the full
+// content is expected to exceed the length limit.
+func (m *message) validateChunk(chunk string) error {
+	c := *m
+	c.content = chunk
+	return c.validateMessage(true, channelSendFlags)
+}
+
+// splitContent splits the content into chunks of at most limit characters. Content is split on paragraph, then
+// line, then word boundaries, preferring boundaries outside of code fences. When a chunk must end inside a code
+// fence, the fence is closed at the end of the chunk and reopened, with the same language, at the start of the next.
+func splitContent(content string, limit int) []string {
+	var chunks []string
+	prefix := ""
+	rest := content
+	for {
+		text := prefix + rest
+		if utf8.RuneCountInString(text) <= limit {
+			return append(chunks, text)
+		}
+
+		cut, next := findCut(text, len(prefix), byteOffset(text, limit))
+		open, lang := fenceState(text[:cut])
+		if open && utf8.RuneCountInString(text[:cut])+len("\n"+codeFence) > limit {
+			// Leave room to close the code fence at the end of the chunk.
+			cut, next = findCut(text, len(prefix), byteOffset(text, limit-len("\n"+codeFence)))
+			open, lang = fenceState(text[:cut])
+		}
+
+		head := text[:cut]
+		if open {
+			head = strings.TrimRight(head, "\n") + "\n" + codeFence
+			prefix = codeFence + lang + "\n"
+		} else {
+			prefix = ""
+		}
+		chunks = append(chunks, head)
+		rest = text[next:]
+	}
+}
+
+// findCut returns the byte offset at which to end the current chunk and the offset at which the next chunk starts.
+// The cut is made after start and at or before end, and never straight after a code fence is opened, which would
+// leave an empty code block at the end of the chunk.
+func findCut(text string, start, end int) (cut, next int) {
+	separators := []string{"\n\n", "\n", " "}
+
+	// Prefer a boundary outside a code fence, then any boundary.
+	for _, outside := range []bool{true, false} {
+		for _, sep := range separators {
+			for i := strings.LastIndex(text[:end], sep); i > start; i = strings.LastIndex(text[:i], sep) {
+				open, _ := fenceState(text[:i])
+				if (!outside || !open) && !(open && opensFence(text[:i])) {
+					return i, i + len(sep)
+				}
+			}
+		}
+	}
+
+	// No boundary found, so split mid-word.
+	if end <= start {
+		end = byteOffset(text, utf8.RuneCountInString(text[:start])+1)
+	}
+	return end, end
+}
+
+// fenceState reports whether the text ends inside a code fence and, if so, the language of that fence.
+func fenceState(text string) (open bool, lang string) {
+	for _, line := range strings.Split(text, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, codeFence) {
+			continue
+		}
+		if open {
+			open, lang = false, ""
+		} else {
+			open, lang = true, strings.TrimSpace(strings.TrimPrefix(trimmed, codeFence))
+		}
+	}
+	return open, lang
+}
+
+// opensFence reports whether the last line of the text opens a code fence.
+func opensFence(text string) bool {
+	text = strings.TrimRight(text, "\n")
+	return strings.HasPrefix(strings.TrimSpace(text[strings.LastIndex(text, "\n")+1:]), codeFence)
+}
+
+// byteOffset returns the byte offset of the n-th rune in the string, or the length of the string if it is shorter.
+func byteOffset(s string, n int) int {
+	if n <= 0 {
+		return 0
+	}
+	count := 0
+	for i := range s {
+		if count == n {
+			return i
+		}
+		count++
+	}
+	return len(s)
+}
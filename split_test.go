@@ -0,0 +1,199 @@
+package disgomsg
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestSplitContentShort(t *testing.T) {
+	chunks := splitContent("hello", 10)
+	if len(chunks) != 1 || chunks[0] != "hello" {
+		t.Errorf("Expected a single chunk, got %q", chunks)
+	}
+	chunks = splitContent("", 10)
+	if len(chunks) != 1 || chunks[0] != "" {
+		t.Errorf("Expected a single empty chunk, got %q", chunks)
+	}
+}
+
+func TestSplitContentBoundaries(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		limit    int
+		expected []string
+	}{
+		{
+			name:     "paragraph",
+			content:  "first line\nsecond\n\nthird paragraph",
+			limit:    25,
+			expected: []string{"first line\nsecond", "third paragraph"},
+		},
+		{
+			name:     "line",
+			content:  "one two three\nfour five six",
+			limit:    20,
+			expected: []string{"one two three", "four five six"},
+		},
+		{
+			name:     "word",
+			content:  "one two three four five",
+			limit:    15,
+			expected: []string{"one two three", "four five"},
+		},
+		{
+			name:     "mid-word",
+			content:  strings.Repeat("a", 20),
+			limit:    14,
+			expected: []string{strings.Repeat("a", 14), strings.Repeat("a", 6)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunks := splitContent(tt.content, tt.limit)
+			if len(chunks) != len(tt.expected) {
+				t.Fatalf("Expected %q, got %q", tt.expected, chunks)
+			}
+			for i := range chunks {
+				if chunks[i] != tt.expected[i] {
+					t.Errorf("Expected chunk %d to be %q, got %q", i, tt.expected[i], chunks[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSplitContentPrefersOutsideFence(t *testing.T) {
+	content := "intro text\n```go\nfmt.Println(1)\n```\nafter"
+	chunks := splitContent(content, 40)
+	if len(chunks) != 2 {
+		t.Fatalf("Expected 2 chunks, got %q", chunks)
+	}
+	if chunks[0] != "intro text\n```go\nfmt.Println(1)\n```" || chunks[1] != "after" {
+		t.Errorf("Unexpected chunks %q", chunks)
+	}
+}
+
+func TestSplitContentReopensFence(t *testing.T) {
+	var lines []string
+	for i := 0; i < 50; i++ {
+		lines = append(lines, "line of code")
+	}
+	content := "```go\n" + strings.Join(lines, "\n") + "\n```"
+	limit := 100
+	chunks := splitContent(content, limit)
+	if len(chunks) < 2 {
+		t.Fatalf("Expected multiple chunks, got %d", len(chunks))
+	}
+	total := 0
+	for i, chunk := range chunks {
+		if n := utf8.RuneCountInString(chunk); n > limit {
+			t.Errorf("Chunk %d has length %d, exceeding %d", i, n, limit)
+		}
+		if !strings.HasPrefix(chunk, "```go\n") {
+			t.Errorf("Chunk %d does not open the fence: %q", i, chunk)
+		}
+		if !strings.HasSuffix(chunk, "\n```") {
+			t.Errorf("Chunk %d does not close the fence: %q", i, chunk)
+		}
+		total += strings.Count(chunk, "line of code")
+	}
+	if total != len(lines) {
+		t.Errorf("Expected %d lines across all chunks, got %d", len(lines), total)
+	}
+}
+
+func TestSplitContentLongFencedLine(t *testing.T) {
+	content := "```py\n" + strings.Repeat("b", 4100)
+	chunks := splitContent(content, MaxContentLength)
+	if len(chunks) != 3 {
+		t.Fatalf("Expected 3 chunks, got %d", len(chunks))
+	}
+	total := 0
+	for i, chunk := range chunks {
+		if n := utf8.RuneCountInString(chunk); n > MaxContentLength {
+			t.Errorf("Chunk %d has length %d, exceeding %d", i, n, MaxContentLength)
+		}
+		if !strings.HasPrefix(chunk, "```py\nb") || (i < len(chunks)-1 && !strings.HasSuffix(chunk, "b\n```")) {
+			t.Errorf("Chunk %d is not a fenced block of content: %.20q", i, chunk)
+		}
+		total += strings.Count(chunk, "b")
+	}
+	if total != 4100 {
+		t.Errorf("Expected 4100 characters across all chunks, got %d", total)
+	}
+}
+
+func TestMessageSendSplit(t *testing.T) {
+	rec := NewRecorder()
+	embeds := []*discordgo.MessageEmbed{{Title: "Report"}}
+	reference := &discordgo.MessageReference{MessageID: "original"}
+	content := strings.Repeat("word ", MaxContentLength/2)
+	msg := NewMessage(WithContent(content), WithEmbeds(embeds), WithReference(reference))
+
+	messageIDs, err := msg.SendSplit(rec, "channel-1")
+	if err != nil {
+		t.Fatalf("SendSplit returned error: %v", err)
+	}
+	requests := rec.Requests()
+	if len(messageIDs) != 3 || len(requests) != 3 {
+		t.Fatalf("Expected 3 messages, got %d IDs and %d requests", len(messageIDs), len(requests))
+	}
+	for i, req := range requests {
+		if req.ChannelID != "channel-1" {
+			t.Errorf("Expected channel-1, got %q", req.ChannelID)
+		}
+		last := i == len(requests)-1
+		if last != (req.MessageSend.Embeds != nil) {
+			t.Errorf("Expected embeds only on the final message, request %d has %v", i, req.MessageSend.Embeds)
+		}
+		if (i == 0) != (req.MessageSend.Reference != nil) {
+			t.Errorf("Expected reference only on the first message, request %d has %v", i, req.MessageSend.Reference)
+		}
+	}
+	if msg.messageID != messageIDs[len(messageIDs)-1] {
+		t.Errorf("Expected messageID %q, got %q", messageIDs[len(messageIDs)-1], msg.messageID)
+	}
+}
+
+func TestDirectMessageSendSplit(t *testing.T) {
+	rec := NewRecorder()
+	dm := NewDirectMessage(WithContent(strings.Repeat("word ", MaxContentLength/4)))
+
+	messageIDs, err := dm.SendSplit(rec, "member-1")
+	if err != nil {
+		t.Fatalf("SendSplit returned error: %v", err)
+	}
+	requests := rec.Requests()
+	if len(messageIDs) != 2 || len(requests) != 3 {
+		t.Fatalf("Expected 2 messages and 3 requests, got %d and %d", len(messageIDs), len(requests))
+	}
+	if requests[0].Method != "UserChannelCreate" {
+		t.Errorf("Expected UserChannelCreate first, got %q", requests[0].Method)
+	}
+	if dm.channelID != requests[1].ChannelID {
+		t.Errorf("Expected channelID %q, got %q", requests[1].ChannelID, dm.channelID)
+	}
+}
+
+func TestSendSplitEmpty(t *testing.T) {
+	rec := NewRecorder()
+	if _, err := NewMessage().SendSplit(rec, "channel-1"); !errors.Is(err, ErrCannotSendEmpty) {
+		t.Errorf("Expected ErrCannotSendEmpty, got %v", err)
+	}
+	if _, err := NewDirectMessage().SendSplit(rec, "member-1"); !errors.Is(err, ErrCannotSendEmpty) {
+		t.Errorf("Expected ErrCannotSendEmpty, got %v", err)
+	}
+	if len(rec.Requests()) != 0 {
+		t.Errorf("Expected no requests, got %d", len(rec.Requests()))
+	}
+
+	messageIDs, err := NewMessage(WithEmbeds([]*discordgo.MessageEmbed{{Title: "Report"}})).SendSplit(rec, "channel-1")
+	if err != nil || len(messageIDs) != 1 {
+		t.Errorf("Expected a message with only embeds to be sent, got %v and %v", messageIDs, err)
+	}
+}
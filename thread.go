@@ -0,0 +1,79 @@
+package disgomsg
+
+import (
+	"context"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// StartThread starts a public thread from the message, which must have been sent or hydrated from an existing
+// message. The auto archive duration is in minutes, and must be 60, 1440, 4320 or 10080, or zero to use the
+// channel's default. The ID of the new thread is returned; the message itself remains in its channel.
+func (m *Message) StartThread(s ThreadSender, name string, autoArchive int, options ...discordgo.RequestOption) (string, error) {
+	return m.StartThreadContext(context.Background(), s, name, autoArchive, options...)
+}
+
+// StartThreadContext is like StartThread, but the requests are bound to the context and any pending retries are
+// abandoned once the context is done.
+func (m *Message) StartThreadContext(ctx context.Context, s ThreadSender, name string, autoArchive int, options ...discordgo.RequestOption) (string, error) {
+	if m.channelID == "" {
+		return "", ErrMissingChannelID
+	}
+	if m.messageID == "" {
+		return "", ErrMissingMessageID
+	}
+	data := &discordgo.ThreadStart{Name: name, AutoArchiveDuration: autoArchive}
+	if err := (*message)(m).validateThreadStart(data); err != nil {
+		return "", err
+	}
+	var thread *discordgo.Channel
+	err := (*message)(m).do(ctx, "start thread", options, func(options ...discordgo.RequestOption) (err error) {
+		thread, err = s.MessageThreadStartComplex(m.channelID, m.messageID, data, options...)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return thread.ID, nil
+}
+
+// SendToNewThread starts a public thread in the channel and sends the message as the first message in the thread.
+// The auto archive duration is in minutes, and must be 60, 1440, 4320 or 10080, or zero to use the channel's
+// default. The message is bound to the thread, so its ChannelID is the ID of the thread and it may later be edited
+// or deleted; this is also the case if the thread is started but the message cannot be sent. The ID of the sent
+// message is returned.
+func (m *Message) SendToNewThread(s ThreadSender, channelID, name string, autoArchive int, options ...discordgo.RequestOption) (string, error) {
+	return m.SendToNewThreadContext(context.Background(), s, channelID, name, autoArchive, options...)
+}
+
+// SendToNewThreadContext is like SendToNewThread, but the requests are bound to the context and any pending retries
+// are abandoned once the context is done.
+func (m *Message) SendToNewThreadContext(ctx context.Context, s ThreadSender, channelID, name string, autoArchive int, options ...discordgo.RequestOption) (string, error) {
+	if m.validate {
+		if err := m.Validate(); err != nil {
+			return "", err
+		}
+	}
+	if channelID == "" {
+		return "", ErrMissingChannelID
+	}
+	data := &discordgo.ThreadStart{
+		Name:                name,
+		AutoArchiveDuration: autoArchive,
+		Type:                discordgo.ChannelTypeGuildPublicThread,
+	}
+	if err := (*message)(m).validateThreadStart(data); err != nil {
+		return "", err
+	}
+	var thread *discordgo.Channel
+	err := (*message)(m).do(ctx, "start thread", options, func(options ...discordgo.RequestOption) (err error) {
+		thread, err = s.ThreadStartComplex(channelID, data, options...)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	m.channelID = thread.ID
+	m.threadID = thread.ID
+	return (*message)(m).sendToChannel(ctx, s, options...)
+}
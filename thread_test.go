@@ -0,0 +1,120 @@
+package disgomsg
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestMessageStartThread(t *testing.T) {
+	rec := NewRecorder()
+	m := NewMessage(WithContent("Release notes"))
+	if _, err := m.Send(rec, "channel-1"); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+
+	threadID, err := m.StartThread(rec, "Discussion", 1440)
+	if err != nil {
+		t.Fatalf("StartThread returned error: %v", err)
+	}
+	req := last(rec)
+	if req.Method != "MessageThreadStartComplex" || req.ChannelID != "channel-1" || req.MessageID != m.MessageID() {
+		t.Errorf("Expected thread started from %q in channel-1, got %+v", m.MessageID(), req)
+	}
+	if req.ThreadStart.Name != "Discussion" || req.ThreadStart.AutoArchiveDuration != 1440 {
+		t.Errorf("Expected thread name and auto archive duration, got %+v", req.ThreadStart)
+	}
+	if threadID == "" {
+		t.Error("Expected the thread ID to be returned")
+	}
+	if m.ChannelID() != "channel-1" {
+		t.Errorf("Expected the message to remain in channel-1, got %q", m.ChannelID())
+	}
+
+	reply := NewMessage(WithContent("First!"), WithThreadID(threadID))
+	if _, err := reply.Send(rec, "channel-1"); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if req := last(rec); req.ChannelID != threadID {
+		t.Errorf("Expected reply to be sent to thread %q, got %q", threadID, req.ChannelID)
+	}
+}
+
+func TestMessageStartThreadErrors(t *testing.T) {
+	rec := NewRecorder()
+	if _, err := NewMessage().StartThread(rec, "Discussion", 0); !errors.Is(err, ErrMissingChannelID) {
+		t.Errorf("Expected ErrMissingChannelID, got %v", err)
+	}
+	m := NewMessage(WithChannelID("channel-1"))
+	if _, err := m.StartThread(rec, "Discussion", 0); !errors.Is(err, ErrMissingMessageID) {
+		t.Errorf("Expected ErrMissingMessageID, got %v", err)
+	}
+	m.WithMessageID("message-1")
+	if _, err := m.StartThread(rec, "", 0); !errors.Is(err, ErrMissingThreadName) {
+		t.Errorf("Expected ErrMissingThreadName, got %v", err)
+	}
+
+	m.WithValidation(true)
+	_, err := m.StartThread(rec, strings.Repeat("n", MaxThreadNameLength+1), 30)
+	fields := validationFields(err)
+	if !hasField(fields, "threadName") || !hasField(fields, "autoArchiveDuration") {
+		t.Errorf("Expected threadName and autoArchiveDuration violations, got %v", fields)
+	}
+	if len(rec.Requests()) != 0 {
+		t.Errorf("Expected no requests, got %d", len(rec.Requests()))
+	}
+}
+
+func TestSendToNewThread(t *testing.T) {
+	rec := NewRecorder()
+	m := NewMessage(WithContent("Investigating"))
+	reason := discordgo.WithAuditLogReason("incident")
+	messageID, err := m.SendToNewThread(rec, "channel-1", "Incident", 60, reason)
+	if err != nil {
+		t.Fatalf("SendToNewThread returned error: %v", err)
+	}
+	if messageID == "" || messageID != m.MessageID() {
+		t.Errorf("Expected message ID %q to be returned, got %q", m.MessageID(), messageID)
+	}
+	requests := rec.Requests()
+	if len(requests) != 2 {
+		t.Fatalf("Expected 2 requests, got %d", len(requests))
+	}
+	start, send := requests[0], requests[1]
+	if start.Method != "ThreadStartComplex" || start.ChannelID != "channel-1" {
+		t.Errorf("Expected thread started in channel-1, got %+v", start)
+	}
+	// Each request carries the context option followed by the audit log reason.
+	if len(start.Options) != 2 || len(send.Options) != 2 {
+		t.Errorf("Expected request options to be passed to both requests, got %d and %d", len(start.Options), len(send.Options))
+	}
+	if start.ThreadStart.Type != discordgo.ChannelTypeGuildPublicThread {
+		t.Errorf("Expected a public thread, got type %d", start.ThreadStart.Type)
+	}
+	if send.Method != "ChannelMessageSendComplex" || send.ChannelID == "channel-1" || send.ChannelID != m.ChannelID() {
+		t.Errorf("Expected message sent to the new thread %q, got %+v", m.ChannelID(), send)
+	}
+
+	if err := m.WithContent("Resolved").Edit(rec); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	if req := last(rec); req.MessageEdit.Channel != m.ChannelID() || req.MessageEdit.ID != m.MessageID() {
+		t.Errorf("Expected edit of %q in the thread, got %+v", m.MessageID(), req.MessageEdit)
+	}
+}
+
+func TestSendToNewThreadSendError(t *testing.T) {
+	sendErr := errors.New("send failed")
+	rec := NewRecorder()
+	rec.Errors = map[string]error{"ChannelMessageSendComplex": sendErr}
+	m := NewMessage(WithContent("Investigating"))
+	_, err := m.SendToNewThread(rec, "channel-1", "Incident", 0)
+	if !errors.Is(err, sendErr) {
+		t.Fatalf("Expected %v, got %v", sendErr, err)
+	}
+	if m.ChannelID() == "" || m.ChannelID() == "channel-1" {
+		t.Errorf("Expected the message to be bound to the started thread, got %q", m.ChannelID())
+	}
+}
@@ -0,0 +1,366 @@
+package disgomsg
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Limits imposed by Discord on messages, embeds, components and interaction responses.
+const (
+	MaxContentLength          = 2000
+	MaxEmbeds                 = 10
+	MaxEmbedTotalLength       = 6000
+	MaxEmbedTitleLength       = 256
+	MaxEmbedDescriptionLength = 4096
+	MaxEmbedFields            = 25
+	MaxEmbedFieldNameLength   = 256
+	MaxEmbedFieldValueLength  = 1024
+	MaxEmbedFooterLength      = 2048
+	MaxEmbedAuthorNameLength  = 256
+	MaxActionRows             = 5
+	MaxActionRowComponents    = 5
+	MaxCustomIDLength         = 100
+	MaxButtonLabelLength      = 80
+	MaxSelectOptions          = 25
+	MaxSelectPlaceholder      = 150
+	MaxSelectOptionLength     = 100
+	MaxTextInputLabelLength   = 45
+	MaxTextInputValueLength   = 4000
+	MaxTextInputPlaceholder   = 100
+	MaxChoices                = 25
+	MaxChoiceNameLength       = 100
+	MaxChoiceValueLength      = 100
+	MaxStickers               = 3
+	MaxFiles                  = 10
+	MaxModalTitleLength       = 45
+	MaxModalComponents        = 5
+	MaxWebhookUsernameLength  = 80
+	MaxThreadNameLength       = 100
+	MaxForumTags              = 5
+)
+
+// Validate checks the message against Discord's documented limits. All violations are returned as a single
+// joined error, with each violation reported as a *ValidationError.
+func (m *Message) Validate() error {
+	return (*message)(m).validateMessage(true, channelSendFlags)
+}
+
+// Validate checks the direct message against Discord's documented limits. All violations are returned as a single
+// joined error, with each violation reported as a *ValidationError.
+func (dm *DirectMessage) Validate() error {
+	return (*message)(dm).validateMessage(true, channelSendFlags)
+}
+
+// Validate checks the interaction response against Discord's documented limits for its response type. All
+// violations are returned as a single joined error, with each violation reported as a *ValidationError.
+func (r *Response) Validate() error {
+	m := (*message)(r)
+	if r.responseType == nil {
+		return m.validateMessage(false, interactionFlags)
+	}
+	switch *r.responseType {
+	case discordgo.InteractionResponseModal:
+		return m.validateModal()
+	case discordgo.InteractionApplicationCommandAutocompleteResult:
+		return m.validateChoices()
+	case discordgo.InteractionResponseChannelMessageWithSource, discordgo.InteractionResponseUpdateMessage:
+		return m.validateMessage(false, interactionFlags)
+	default:
+		return nil
+	}
+}
+
+// validator accumulates validation errors.
+type validator struct {
+	errs []error
+}
+
+// add records a violation for the given field.
+func (v *validator) add(field string, format string, args ...any) {
+	v.errs = append(v.errs, &ValidationError{Field: field, Reason: fmt.Sprintf(format, args...)})
+}
+
+// maxLength records a violation if the string is longer than max characters.
+func (v *validator) maxLength(field string, s string, max int) {
+	if n := utf8.RuneCountInString(s); n > max {
+		v.add(field, "length %d exceeds maximum of %d", n, max)
+	}
+}
+
+// maxCount records a violation if there are more than max items.
+func (v *validator) maxCount(field string, n int, max int) {
+	if n > max {
+		v.add(field, "count %d exceeds maximum of %d", n, max)
+	}
+}
+
+// err returns the joined validation errors, or nil if there were none.
+func (v *validator) err() error {
+	return errors.Join(v.errs...)
+}
+
+// validateMessage validates the fields used when sending a message. When requireBody is set, a message without
+// any content, embeds, components, files or stickers is also reported. Any flags not in allowedFlags are reported.
+func (m *message) validateMessage(requireBody bool, allowedFlags discordgo.MessageFlags) error {
+	v := &validator{}
+	m.checkMessage(v, requireBody, allowedFlags)
+	return v.err()
+}
+
+// checkMessage records any violations in the fields used when sending a message, as described by validateMessage.
+func (m *message) checkMessage(v *validator, requireBody bool, allowedFlags discordgo.MessageFlags) {
+	if requireBody && !m.hasBody() {
+		v.add("content", "message must have content, embeds, components, files or stickers")
+	}
+	v.maxLength("content", m.content, MaxContentLength)
+	v.validateEmbeds(m.embeds)
+	v.validateComponents("components", m.components, false)
+	v.maxCount("stickerIDs", len(m.stickerIDs), MaxStickers)
+	v.maxCount("files", len(m.files), MaxFiles)
+	v.validateFlags(m.flags, allowedFlags)
+}
+
+// hasBody reports whether the message has any content, embeds, components, files or stickers to send.
+func (m *message) hasBody() bool {
+	return m.content != "" || len(m.embeds) > 0 || len(m.components) > 0 || len(m.files) > 0 || len(m.stickerIDs) > 0
+}
+
+// validateEdit validates the fields used when editing a channel message. When the flags are part of the edit, any
+// flag a channel message cannot carry is reported. Flags that may only be set when sending, such as suppress
+// notifications, are accepted but left out of the edit, so a message sent with them may still be edited.
+func (m *message) validateEdit() error {
+	allowedFlags := ^discordgo.MessageFlags(0)
+	if m.editMask(false)&fieldFlags != 0 {
+		allowedFlags = channelSendFlags
+	}
+	return m.validateMessage(false, allowedFlags)
+}
+
+// validateWebhookEdit validates the fields used when editing an interaction response, follow-up or webhook message.
+// These edits do not carry flags, so the flags are not checked.
+func (m *message) validateWebhookEdit() error {
+	return m.validateMessage(false, ^discordgo.MessageFlags(0))
+}
+
+// validateModal validates the fields used when sending a modal.
+func (m *message) validateModal() error {
+	v := &validator{}
+	if m.title == "" {
+		v.add("title", "modal title is required")
+	}
+	v.maxLength("title", m.title, MaxModalTitleLength)
+	if m.customID == "" {
+		v.add("customID", "modal custom ID is required")
+	}
+	v.maxLength("customID", m.customID, MaxCustomIDLength)
+	if len(m.components) == 0 {
+		v.add("components", "modal must have at least one component")
+	}
+	v.maxCount("components", len(m.components), MaxModalComponents)
+	v.validateComponents("components", m.components, true)
+	return v.err()
+}
+
+// validateChoices validates the fields used when sending an autocomplete result.
+func (m *message) validateChoices() error {
+	v := &validator{}
+	v.maxCount("choices", len(m.choices), MaxChoices)
+	for i, choice := range m.choices {
+		field := fmt.Sprintf("choices[%d]", i)
+		if choice == nil {
+			v.add(field, "choice is nil")
+			continue
+		}
+		v.maxLength(field+".name", choice.Name, MaxChoiceNameLength)
+		if s, ok := choice.Value.(string); ok {
+			v.maxLength(field+".value", s, MaxChoiceValueLength)
+		}
+	}
+	return v.err()
+}
+
+// validateFlags records a violation if any of the flags are not in the allowed set.
+func (v *validator) validateFlags(flags, allowed discordgo.MessageFlags) {
+	if invalid := flags &^ allowed; invalid != 0 {
+		v.add("flags", "flags %d are not accepted for this request", invalid)
+	}
+}
+
+// validateEmbeds validates the embeds, including the combined length of all embeds.
+func (v *validator) validateEmbeds(embeds []*discordgo.MessageEmbed) {
+	v.maxCount("embeds", len(embeds), MaxEmbeds)
+	total := 0
+	for i, embed := range embeds {
+		field := fmt.Sprintf("embeds[%d]", i)
+		if embed == nil {
+			v.add(field, "embed is nil")
+			continue
+		}
+		v.maxLength(field+".title", embed.Title, MaxEmbedTitleLength)
+		v.maxLength(field+".description", embed.Description, MaxEmbedDescriptionLength)
+		total += embedLength(embed)
+		if embed.Footer != nil {
+			v.maxLength(field+".footer.text", embed.Footer.Text, MaxEmbedFooterLength)
+		}
+		if embed.Author != nil {
+			v.maxLength(field+".author.name", embed.Author.Name, MaxEmbedAuthorNameLength)
+		}
+		v.maxCount(field+".fields", len(embed.Fields), MaxEmbedFields)
+		for j, f := range embed.Fields {
+			fieldPath := fmt.Sprintf("%s.fields[%d]", field, j)
+			if f == nil {
+				v.add(fieldPath, "field is nil")
+				continue
+			}
+			v.maxLength(fieldPath+".name", f.Name, MaxEmbedFieldNameLength)
+			v.maxLength(fieldPath+".value", f.Value, MaxEmbedFieldValueLength)
+		}
+	}
+	if total > MaxEmbedTotalLength {
+		v.add("embeds", "combined length %d exceeds maximum of %d", total, MaxEmbedTotalLength)
+	}
+}
+
+// validateComponents validates the top-level components, which must all be action rows. Text inputs are only
+// permitted when validating a modal, in which case each row must contain exactly one text input.
+func (v *validator) validateComponents(field string, components []discordgo.MessageComponent, modal bool) {
+	if !modal {
+		v.maxCount(field, len(components), MaxActionRows)
+	}
+	for i, component := range components {
+		rowField := fmt.Sprintf("%s[%d]", field, i)
+		row, ok := asActionsRow(component)
+		if !ok {
+			v.add(rowField, "top-level component must be an action row")
+			continue
+		}
+		rowField += ".components"
+		if len(row.Components) == 0 {
+			v.add(rowField, "action row must contain at least one component")
+		}
+		if modal && len(row.Components) > 1 {
+			v.add(rowField, "modal action row must contain exactly one text input")
+		}
+		v.maxCount(rowField, len(row.Components), MaxActionRowComponents)
+		for j, child := range row.Components {
+			v.validateComponent(fmt.Sprintf("%s[%d]", rowField, j), child, len(row.Components), modal)
+		}
+	}
+}
+
+// validateComponent validates a single component nested within an action row containing rowSize components.
+func (v *validator) validateComponent(field string, component discordgo.MessageComponent, rowSize int, modal bool) {
+	switch c := component.(type) {
+	case discordgo.Button:
+		v.validateButton(field, &c, modal)
+	case *discordgo.Button:
+		v.validateButton(field, c, modal)
+	case discordgo.SelectMenu:
+		v.validateSelectMenu(field, &c, rowSize, modal)
+	case *discordgo.SelectMenu:
+		v.validateSelectMenu(field, c, rowSize, modal)
+	case discordgo.TextInput:
+		v.validateTextInput(field, &c, modal)
+	case *discordgo.TextInput:
+		v.validateTextInput(field, c, modal)
+	case discordgo.ActionsRow, *discordgo.ActionsRow:
+		v.add(field, "action rows cannot be nested")
+	case nil:
+		v.add(field, "component is nil")
+	}
+}
+
+// validateButton validates a button component.
+func (v *validator) validateButton(field string, b *discordgo.Button, modal bool) {
+	if modal {
+		v.add(field, "buttons are not permitted in a modal")
+	}
+	v.maxLength(field+".label", b.Label, MaxButtonLabelLength)
+	v.maxLength(field+".customID", b.CustomID, MaxCustomIDLength)
+	if b.Style == discordgo.LinkButton {
+		if b.URL == "" {
+			v.add(field+".url", "link button requires a URL")
+		}
+		if b.CustomID != "" {
+			v.add(field+".customID", "link button cannot have a custom ID")
+		}
+	} else if b.CustomID == "" {
+		v.add(field+".customID", "button requires a custom ID")
+	}
+}
+
+// validateSelectMenu validates a select menu component.
+func (v *validator) validateSelectMenu(field string, s *discordgo.SelectMenu, rowSize int, modal bool) {
+	if modal {
+		v.add(field, "select menus are not permitted in a modal")
+	}
+	if rowSize > 1 {
+		v.add(field, "select menu must be the only component in its action row")
+	}
+	if s.CustomID == "" {
+		v.add(field+".customID", "select menu requires a custom ID")
+	}
+	v.maxLength(field+".customID", s.CustomID, MaxCustomIDLength)
+	v.maxLength(field+".placeholder", s.Placeholder, MaxSelectPlaceholder)
+	v.maxCount(field+".options", len(s.Options), MaxSelectOptions)
+	for i, opt := range s.Options {
+		optField := fmt.Sprintf("%s.options[%d]", field, i)
+		v.maxLength(optField+".label", opt.Label, MaxSelectOptionLength)
+		v.maxLength(optField+".value", opt.Value, MaxSelectOptionLength)
+		v.maxLength(optField+".description", opt.Description, MaxSelectOptionLength)
+	}
+}
+
+// validateTextInput validates a text input component.
+func (v *validator) validateTextInput(field string, t *discordgo.TextInput, modal bool) {
+	if !modal {
+		v.add(field, "text inputs are only permitted in a modal")
+	}
+	if t.CustomID == "" {
+		v.add(field+".customID", "text input requires a custom ID")
+	}
+	v.maxLength(field+".customID", t.CustomID, MaxCustomIDLength)
+	v.maxLength(field+".label", t.Label, MaxTextInputLabelLength)
+	v.maxLength(field+".value", t.Value, MaxTextInputValueLength)
+	v.maxLength(field+".placeholder", t.Placeholder, MaxTextInputPlaceholder)
+	if t.MaxLength > MaxTextInputValueLength {
+		v.add(field+".maxLength", "%d exceeds maximum of %d", t.MaxLength, MaxTextInputValueLength)
+	}
+	if t.MinLength > t.MaxLength && t.MaxLength > 0 {
+		v.add(field+".minLength", "%d exceeds max length %d", t.MinLength, t.MaxLength)
+	}
+}
+
+// asActionsRow returns the component as an action row, if it is one.
+func asActionsRow(component discordgo.MessageComponent) (*discordgo.ActionsRow, bool) {
+	switch c := component.(type) {
+	case discordgo.ActionsRow:
+		return &c, true
+	case *discordgo.ActionsRow:
+		return c, c != nil
+	default:
+		return nil, false
+	}
+}
+
+// validateThreadStart checks the thread about to be started. A thread without a name is always rejected; when
+// validation is enabled, the name's length and the auto archive duration are also checked.
+func (m *message) validateThreadStart(data *discordgo.ThreadStart) error {
+	if data.Name == "" {
+		return ErrMissingThreadName
+	}
+	if !m.validate {
+		return nil
+	}
+	v := &validator{}
+	v.maxLength("threadName", data.Name, MaxThreadNameLength)
+	switch data.AutoArchiveDuration {
+	case 0, 60, 1440, 4320, 10080:
+	default:
+		v.add("autoArchiveDuration", "%d minutes is not one of 60, 1440, 4320 or 10080", data.AutoArchiveDuration)
+	}
+	return v.err()
+}
@@ -0,0 +1,179 @@
+package disgomsg
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// validationFields returns the field paths of all validation errors joined in err.
+func validationFields(err error) []string {
+	var fields []string
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return nil
+	}
+	for _, e := range joined.Unwrap() {
+		var ve *ValidationError
+		if errors.As(e, &ve) {
+			fields = append(fields, ve.Field)
+		}
+	}
+	return fields
+}
+
+// hasField reports whether the field is in the list of fields.
+func hasField(fields []string, field string) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+func TestMessageValidateValid(t *testing.T) {
+	msg := NewMessage(
+		WithContent("hello"),
+		WithEmbeds([]*discordgo.MessageEmbed{{Title: "title", Description: "description"}}),
+		WithComponents([]discordgo.MessageComponent{
+			discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+				discordgo.Button{Label: "OK", CustomID: "ok"},
+				discordgo.Button{Label: "Docs", Style: discordgo.LinkButton, URL: "https://example.com"},
+			}},
+		}),
+	)
+	if err := msg.Validate(); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestMessageValidateViolations(t *testing.T) {
+	embeds := make([]*discordgo.MessageEmbed, MaxEmbeds+1)
+	for i := range embeds {
+		embeds[i] = &discordgo.MessageEmbed{Description: strings.Repeat("d", 600)}
+	}
+	embeds[0].Title = strings.Repeat("t", MaxEmbedTitleLength+1)
+	embeds[1].Fields = []*discordgo.MessageEmbedField{{Name: "name", Value: strings.Repeat("v", MaxEmbedFieldValueLength+1)}}
+	embeds[2].Footer = &discordgo.MessageEmbedFooter{Text: strings.Repeat("f", MaxEmbedFooterLength+1)}
+	embeds[3].Author = &discordgo.MessageEmbedAuthor{Name: strings.Repeat("a", MaxEmbedAuthorNameLength+1)}
+
+	rows := make([]discordgo.MessageComponent, MaxActionRows+1)
+	for i := range rows {
+		rows[i] = discordgo.ActionsRow{Components: []discordgo.MessageComponent{discordgo.Button{Label: "b", CustomID: "b"}}}
+	}
+	rows[0] = discordgo.Button{Label: "not in a row", CustomID: "x"}
+	rows[1] = discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+		discordgo.SelectMenu{CustomID: strings.Repeat("c", MaxCustomIDLength+1)},
+		discordgo.Button{Label: "b", CustomID: "b"},
+	}}
+
+	msg := NewMessage(
+		WithContent(strings.Repeat("x", MaxContentLength+1)),
+		WithEmbeds(embeds),
+		WithComponents(rows),
+		WithStickerIDs([]string{"1", "2", "3", "4"}),
+	)
+	err := msg.Validate()
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("Expected validation error, got %v", err)
+	}
+
+	fields := validationFields(err)
+	expected := []string{
+		"content",
+		"embeds",
+		"embeds[0].title",
+		"embeds[1].fields[0].value",
+		"embeds[2].footer.text",
+		"embeds[3].author.name",
+		"components",
+		"components[0]",
+		"components[1].components[0]",
+		"components[1].components[0].customID",
+		"stickerIDs",
+	}
+	for _, field := range expected {
+		if !hasField(fields, field) {
+			t.Errorf("Expected violation for %q, got %v", field, fields)
+		}
+	}
+}
+
+func TestMessageValidateEmpty(t *testing.T) {
+	if err := NewMessage().Validate(); err == nil {
+		t.Error("Expected error for an empty message")
+	}
+	if err := NewDirectMessage().Validate(); err == nil {
+		t.Error("Expected error for an empty direct message")
+	}
+}
+
+func TestResponseValidateModal(t *testing.T) {
+	responseType := discordgo.InteractionResponseModal
+	resp := NewResponse(
+		WithResponseType(&responseType),
+		WithTitle(strings.Repeat("t", MaxModalTitleLength+1)),
+		WithCustomID("modal"),
+		WithComponents([]discordgo.MessageComponent{
+			discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+				discordgo.TextInput{CustomID: "name", Label: "Name", Style: discordgo.TextInputShort},
+			}},
+			discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+				discordgo.Button{Label: "b", CustomID: "b"},
+			}},
+		}),
+	)
+	fields := validationFields(resp.Validate())
+	if !hasField(fields, "title") {
+		t.Errorf("Expected title violation, got %v", fields)
+	}
+	if !hasField(fields, "components[1].components[0]") {
+		t.Errorf("Expected button violation, got %v", fields)
+	}
+	if hasField(fields, "components[0].components[0]") {
+		t.Errorf("Did not expect text input violation, got %v", fields)
+	}
+}
+
+func TestResponseValidateChoices(t *testing.T) {
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, MaxChoices+1)
+	for i := range choices {
+		choices[i] = &discordgo.ApplicationCommandOptionChoice{Name: "name", Value: "value"}
+	}
+	choices[0].Name = strings.Repeat("n", MaxChoiceNameLength+1)
+	responseType := discordgo.InteractionApplicationCommandAutocompleteResult
+	resp := NewResponse(
+		WithResponseType(&responseType),
+		WithChoices(choices),
+	)
+	fields := validationFields(resp.Validate())
+	if !hasField(fields, "choices") || !hasField(fields, "choices[0].name") {
+		t.Errorf("Expected choice violations, got %v", fields)
+	}
+}
+
+func TestValidateBeforeSend(t *testing.T) {
+	rec := NewRecorder()
+	content := strings.Repeat("x", MaxContentLength+1)
+
+	if _, err := NewMessage(WithContent(content), WithValidation(true)).Send(rec, "channel"); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected validation error from Message.Send, got %v", err)
+	}
+	if _, err := NewDirectMessage(WithContent(content), WithValidation(true)).Send(rec, "member"); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected validation error from DirectMessage.Send, got %v", err)
+	}
+	if err := NewResponse(WithContent(content), WithValidation(true)).Send(rec, &discordgo.Interaction{}); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected validation error from Response.Send, got %v", err)
+	}
+	if len(rec.Requests()) != 0 {
+		t.Errorf("Expected no requests to be sent, got %d", len(rec.Requests()))
+	}
+
+	// Validation is opt-in
+	if _, err := NewMessage(WithContent(content)).Send(rec, "channel"); err != nil {
+		t.Errorf("Expected no error without validation, got %v", err)
+	}
+}
@@ -0,0 +1,161 @@
+package disgomsg
+
+import (
+	"context"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// WebhookMessage is a Discord webhook message representation used for sending, editing and deleting messages
+// through a webhook, optionally with a custom username and avatar, or in a thread.
+type WebhookMessage message
+
+// NewWebhookMessage creates a new message instance with the provided options that may be sent through a webhook.
+func NewWebhookMessage(opts ...Option) *WebhookMessage {
+	message := newMessage(opts...)
+	return (*WebhookMessage)(message)
+}
+
+// Send sends the message through the webhook using the provided Discord session. If a thread ID has been set, the
+// message is sent to that thread; if a thread name has been set, a new thread is created in the webhook's forum or
+// media channel. The ID of the sent message is returned, and the webhook and thread are retained so the message may
+// later be edited or deleted.
+func (w *WebhookMessage) Send(s WebhookSender, webhookID, token string, options ...discordgo.RequestOption) (string, error) {
+	return w.SendContext(context.Background(), s, webhookID, token, options...)
+}
+
+// SendContext is like Send, but the requests are bound to the context and any pending retries are abandoned once the
+// context is done.
+func (w *WebhookMessage) SendContext(ctx context.Context, s WebhookSender, webhookID, token string, options ...discordgo.RequestOption) (string, error) {
+	if w.validate {
+		if err := w.Validate(); err != nil {
+			return "", err
+		}
+	}
+	if webhookID == "" || token == "" {
+		return "", ErrMissingWebhook
+	}
+	w.webhookID = webhookID
+	w.webhookToken = token
+	params := w.ToWebhookParams()
+	var sent *discordgo.Message
+	err := (*message)(w).do(ctx, "execute webhook", options, func(options ...discordgo.RequestOption) (err error) {
+		// Wait for the message to be created, so its ID is returned and it may be edited or deleted.
+		if w.threadID != "" {
+			sent, err = s.WebhookThreadExecute(w.webhookID, w.webhookToken, true, w.threadID, params, options...)
+		} else {
+			sent, err = s.WebhookExecute(w.webhookID, w.webhookToken, true, params, options...)
+		}
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	w.messageID = sent.ID
+	w.channelID = sent.ChannelID
+	if w.threadID != "" || w.threadName != "" {
+		// The message was sent to a thread, which must be targeted when it is edited or deleted.
+		w.threadID = sent.ChannelID
+	}
+	(*message)(w).markSent()
+
+	return sent.ID, nil
+}
+
+// Edit edits the message previously sent through the webhook using the provided Discord session. Only the fields
+// changed since the message was last sent or edited are updated, unless WithFullEdit is set.
+func (w *WebhookMessage) Edit(s WebhookSender, options ...discordgo.RequestOption) error {
+	return w.EditContext(context.Background(), s, options...)
+}
+
+// EditContext is like Edit, but the requests are bound to the context and any pending retries are abandoned once the
+// context is done.
+func (w *WebhookMessage) EditContext(ctx context.Context, s WebhookSender, options ...discordgo.RequestOption) error {
+	if w.webhookID == "" || w.webhookToken == "" {
+		return ErrMissingWebhook
+	}
+	if w.messageID == "" {
+		return ErrMissingMessageID
+	}
+	if w.validate {
+		if err := (*message)(w).validateWebhookEdit(); err != nil {
+			return err
+		}
+	}
+	webhookEdit := w.ToWebhookEdit()
+	options = w.threadOptions(options)
+	err := (*message)(w).do(ctx, "edit webhook message", options, func(options ...discordgo.RequestOption) error {
+		_, err := s.WebhookMessageEdit(w.webhookID, w.webhookToken, w.messageID, webhookEdit, options...)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	(*message)(w).markSent()
+
+	return nil
+}
+
+// Delete deletes the message previously sent through the webhook using the provided Discord session and clears the
+// MessageID to indicate it has been deleted.
+func (w *WebhookMessage) Delete(s WebhookSender, options ...discordgo.RequestOption) error {
+	return w.DeleteContext(context.Background(), s, options...)
+}
+
+// DeleteContext is like Delete, but the requests are bound to the context and any pending retries are abandoned once
+// the context is done.
+func (w *WebhookMessage) DeleteContext(ctx context.Context, s WebhookSender, options ...discordgo.RequestOption) error {
+	if w.webhookID == "" || w.webhookToken == "" {
+		return ErrMissingWebhook
+	}
+	if w.messageID == "" {
+		return ErrMissingMessageID
+	}
+	options = w.threadOptions(options)
+	err := (*message)(w).do(ctx, "delete webhook message", options, func(options ...discordgo.RequestOption) error {
+		return s.WebhookMessageDelete(w.webhookID, w.webhookToken, w.messageID, options...)
+	})
+	if err != nil {
+		return err
+	}
+	w.messageID = "" // Clear the ID after deletion
+	return nil
+}
+
+// ThreadID returns the ID of the thread the message is sent to, including a thread created when the message was
+// sent, or an empty string if it is not sent to a thread.
+func (w *WebhookMessage) ThreadID() string {
+	return w.threadID
+}
+
+// Validate checks the webhook message against Discord's documented limits. All violations are returned as a single
+// joined error, with each violation reported as a *ValidationError.
+func (w *WebhookMessage) Validate() error {
+	m := (*message)(w)
+	v := &validator{}
+	m.checkMessage(v, true, channelSendFlags)
+	v.maxLength("username", m.username, MaxWebhookUsernameLength)
+	v.maxLength("threadName", m.threadName, MaxThreadNameLength)
+	if m.threadID != "" && m.threadName != "" {
+		v.add("threadName", "a thread name cannot be set when sending to an existing thread")
+	}
+	return v.err()
+}
+
+// threadOptions adds the thread the message was sent to, if any, to the request options, since discordgo does not
+// support targeting a thread when editing or deleting a webhook message.
+func (w *WebhookMessage) threadOptions(options []discordgo.RequestOption) []discordgo.RequestOption {
+	if w.threadID == "" {
+		return options
+	}
+	return append([]discordgo.RequestOption{withThreadID(w.threadID)}, options...)
+}
+
+// withThreadID returns a request option that adds the thread_id query parameter to the request.
+func withThreadID(threadID string) discordgo.RequestOption {
+	return func(cfg *discordgo.RequestConfig) {
+		query := cfg.Request.URL.Query()
+		query.Set("thread_id", threadID)
+		cfg.Request.URL.RawQuery = query.Encode()
+	}
+}
@@ -0,0 +1,172 @@
+package disgomsg
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestNewWebhookMessage(t *testing.T) {
+	w := NewWebhookMessage(WithContent("hello"), WithUsername("Reporter"), WithAvatarURL("https://example.com/a.png"))
+	params := w.ToWebhookParams()
+	if params.Content != "hello" || params.Username != "Reporter" || params.AvatarURL != "https://example.com/a.png" {
+		t.Errorf("Expected content, username and avatar in params, got %+v", params)
+	}
+}
+
+func TestWebhookMessageSendEditDelete(t *testing.T) {
+	rec := NewRecorder()
+	w := NewWebhookMessage(WithContent("hello"), WithUsername("Reporter"))
+
+	id, err := w.Send(rec, "webhook-1", "token")
+	if err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	req := last(rec)
+	if req.Method != "WebhookExecute" || req.WebhookID != "webhook-1" {
+		t.Errorf("Expected WebhookExecute for webhook-1, got %s for %q", req.Method, req.WebhookID)
+	}
+	if req.WebhookParams.Username != "Reporter" {
+		t.Errorf("Expected username override, got %q", req.WebhookParams.Username)
+	}
+	if id == "" || w.messageID != id {
+		t.Errorf("Expected message ID %q to be recorded, got %q", id, w.messageID)
+	}
+
+	if err := w.WithContent("updated").Edit(rec); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	req = last(rec)
+	if req.Method != "WebhookMessageEdit" || req.MessageID != id || req.ThreadID != "" {
+		t.Errorf("Expected WebhookMessageEdit of %q outside a thread, got %+v", id, req)
+	}
+	if req.WebhookEdit.Content == nil || *req.WebhookEdit.Content != "updated" {
+		t.Errorf("Expected updated content, got %v", req.WebhookEdit.Content)
+	}
+
+	if err := w.Delete(rec); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	if req := last(rec); req.Method != "WebhookMessageDelete" || req.MessageID != id {
+		t.Errorf("Expected WebhookMessageDelete of %q, got %+v", id, req)
+	}
+	if w.messageID != "" {
+		t.Errorf("Expected message ID to be cleared, got %q", w.messageID)
+	}
+}
+
+func TestWebhookMessageThread(t *testing.T) {
+	rec := NewRecorder()
+	w := NewWebhookMessage(WithContent("hello"), WithThreadID("thread-1"))
+	if _, err := w.Send(rec, "webhook-1", "token"); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if req := last(rec); req.Method != "WebhookThreadExecute" || req.ThreadID != "thread-1" {
+		t.Errorf("Expected WebhookThreadExecute to thread-1, got %s to %q", req.Method, req.ThreadID)
+	}
+	if err := w.WithContent("updated").Edit(rec); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	if req := last(rec); req.ThreadID != "thread-1" {
+		t.Errorf("Expected edit to target thread-1, got %q", req.ThreadID)
+	}
+	if err := w.Delete(rec); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	if req := last(rec); req.ThreadID != "thread-1" {
+		t.Errorf("Expected delete to target thread-1, got %q", req.ThreadID)
+	}
+}
+
+func TestWebhookMessageForumThread(t *testing.T) {
+	rec := NewRecorder()
+	w := NewWebhookMessage(WithContent("hello"), WithThreadName("Weekly report"))
+	if _, err := w.Send(rec, "webhook-1", "token"); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	req := last(rec)
+	if req.Method != "WebhookExecute" || req.WebhookParams.ThreadName != "Weekly report" {
+		t.Errorf("Expected WebhookExecute creating a thread, got %s with %+v", req.Method, req.WebhookParams)
+	}
+	if w.ThreadID() == "" {
+		t.Fatal("Expected the created thread to be recorded")
+	}
+	if err := w.Delete(rec); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	if req := last(rec); req.ThreadID != w.ThreadID() {
+		t.Errorf("Expected delete to target thread %q, got %q", w.ThreadID(), req.ThreadID)
+	}
+}
+
+func TestWebhookMessageMissingWebhook(t *testing.T) {
+	rec := NewRecorder()
+	w := NewWebhookMessage(WithContent("hello"))
+	if _, err := w.Send(rec, "", "token"); !errors.Is(err, ErrMissingWebhook) {
+		t.Errorf("Expected ErrMissingWebhook from Send, got %v", err)
+	}
+	if err := w.Edit(rec); !errors.Is(err, ErrMissingWebhook) {
+		t.Errorf("Expected ErrMissingWebhook from Edit, got %v", err)
+	}
+	if err := w.WithWebhook("webhook-1", "token").Delete(rec); !errors.Is(err, ErrMissingMessageID) {
+		t.Errorf("Expected ErrMissingMessageID from Delete, got %v", err)
+	}
+	if len(rec.Requests()) != 0 {
+		t.Errorf("Expected no requests, got %d", len(rec.Requests()))
+	}
+}
+
+func TestWebhookMessageValidate(t *testing.T) {
+	w := NewWebhookMessage(
+		WithContent("hello"),
+		WithUsername(strings.Repeat("u", MaxWebhookUsernameLength+1)),
+		WithThreadID("thread-1"),
+		WithThreadName("thread"),
+	)
+	fields := validationFields(w.Validate())
+	if !hasField(fields, "username") || !hasField(fields, "threadName") {
+		t.Errorf("Expected username and threadName violations, got %v", fields)
+	}
+	if err := NewWebhookMessage(WithContent("hello")).Validate(); err != nil {
+		t.Errorf("Expected valid message, got %v", err)
+	}
+}
+
+func TestWebhookMessageQuery(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		queries []string
+	)
+	s := newFakeDiscord(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		queries = append(queries, r.Method+" "+r.URL.RawQuery)
+		mu.Unlock()
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		respond(w, http.StatusOK, `{"id": "message-1", "channel_id": "thread-1"}`)
+	})
+
+	w := NewWebhookMessage(WithContent("hello"), WithThreadID("thread-1"))
+	if _, err := w.Send(s, "webhook-1", "token"); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if err := w.WithContent("updated").Edit(s); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	if err := w.Delete(s); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+
+	want := []string{
+		"POST thread_id=thread-1&wait=true",
+		"PATCH thread_id=thread-1",
+		"DELETE thread_id=thread-1",
+	}
+	if strings.Join(queries, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Expected queries %q, got %q", want, queries)
+	}
+}
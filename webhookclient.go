@@ -0,0 +1,167 @@
+package disgomsg
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// webhookHosts are the hosts that serve Discord webhook URLs.
+var webhookHosts = map[string]bool{
+	"discord.com":           true,
+	"canary.discord.com":    true,
+	"ptb.discord.com":       true,
+	"discordapp.com":        true,
+	"canary.discordapp.com": true,
+	"ptb.discordapp.com":    true,
+}
+
+// ParseWebhookURL returns the webhook ID and token from a Discord webhook URL, such as
+// https://discord.com/api/webhooks/123/token. URLs with an API version, such as /api/v10/webhooks/123/token, are also
+// accepted. An error wrapping ErrInvalidWebhookURL is returned if the URL is not a Discord webhook URL.
+func ParseWebhookURL(webhookURL string) (webhookID, token string, err error) {
+	u, err := url.Parse(strings.TrimSpace(webhookURL))
+	if err != nil {
+		return "", "", fmt.Errorf("%w: %w", ErrInvalidWebhookURL, err)
+	}
+	if u.Scheme != "https" {
+		return "", "", fmt.Errorf("%w: scheme must be https", ErrInvalidWebhookURL)
+	}
+	if !webhookHosts[strings.ToLower(u.Hostname())] {
+		return "", "", fmt.Errorf("%w: %q is not a Discord host", ErrInvalidWebhookURL, u.Host)
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) > 0 && parts[0] == "api" {
+		parts = parts[1:]
+	}
+	if len(parts) > 0 && isAPIVersion(parts[0]) {
+		parts = parts[1:]
+	}
+	if len(parts) != 3 || parts[0] != "webhooks" {
+		return "", "", fmt.Errorf("%w: path must be /api/webhooks/{id}/{token}", ErrInvalidWebhookURL)
+	}
+	webhookID, token = parts[1], parts[2]
+	if !isSnowflake(webhookID) {
+		return "", "", fmt.Errorf("%w: %q is not a valid webhook ID", ErrInvalidWebhookURL, webhookID)
+	}
+	if !isWebhookToken(token) {
+		return "", "", fmt.Errorf("%w: malformed webhook token", ErrInvalidWebhookURL)
+	}
+	return webhookID, token, nil
+}
+
+// isAPIVersion reports whether the path segment is an API version, such as v10.
+func isAPIVersion(s string) bool {
+	return len(s) > 1 && s[0] == 'v' && isSnowflake(s[1:])
+}
+
+// isSnowflake reports whether the string consists only of digits, as Discord IDs do.
+func isSnowflake(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isWebhookToken reports whether the string consists only of the characters used in webhook tokens.
+func isWebhookToken(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// WebhookClient sends, edits and deletes messages through a single webhook, using only the webhook's URL. It needs
+// neither a bot token nor a gateway connection. A WebhookClient is a WebhookSender, so messages it sends may be
+// edited and deleted with their Edit and Delete methods.
+type WebhookClient struct {
+	session   *discordgo.Session
+	webhookID string
+	token     string
+}
+
+// NewWebhookClient creates a client for the webhook with the given URL. An error wrapping ErrInvalidWebhookURL is
+// returned if the URL is not a Discord webhook URL.
+func NewWebhookClient(webhookURL string) (*WebhookClient, error) {
+	webhookID, token, err := ParseWebhookURL(webhookURL)
+	if err != nil {
+		return nil, err
+	}
+	// Without a token, requests are sent without authentication, which webhook endpoints do not require.
+	session, err := discordgo.New("")
+	if err != nil {
+		return nil, err
+	}
+	session.StateEnabled = false
+	return &WebhookClient{session: session, webhookID: webhookID, token: token}, nil
+}
+
+// WebhookID returns the ID of the client's webhook.
+func (c *WebhookClient) WebhookID() string {
+	return c.webhookID
+}
+
+// Session returns the session used to make requests, so its HTTP client, user agent or retry settings may be
+// changed. The session is never connected to the gateway.
+func (c *WebhookClient) Session() *discordgo.Session {
+	return c.session
+}
+
+// NewMessage creates a new webhook message with the provided options, bound to the client's webhook.
+func (c *WebhookClient) NewMessage(opts ...Option) *WebhookMessage {
+	return NewWebhookMessage(append([]Option{WithWebhook(c.webhookID, c.token)}, opts...)...)
+}
+
+// Send creates a message with the provided options and sends it through the client's webhook. The sent message is
+// returned so it may later be edited or deleted.
+func (c *WebhookClient) Send(opts ...Option) (*WebhookMessage, error) {
+	return c.SendContext(context.Background(), opts...)
+}
+
+// SendContext is like Send, but the requests are bound to the context and any pending retries are abandoned once the
+// context is done.
+func (c *WebhookClient) SendContext(ctx context.Context, opts ...Option) (*WebhookMessage, error) {
+	w := c.NewMessage(opts...)
+	if _, err := w.SendContext(ctx, c, c.webhookID, c.token); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// WebhookExecute sends the webhook message using the client's session.
+func (c *WebhookClient) WebhookExecute(webhookID, token string, wait bool, data *discordgo.WebhookParams, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	return c.session.WebhookExecute(webhookID, token, wait, data, options...)
+}
+
+// WebhookThreadExecute sends the webhook message to the thread using the client's session.
+func (c *WebhookClient) WebhookThreadExecute(webhookID, token string, wait bool, threadID string, data *discordgo.WebhookParams, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	return c.session.WebhookThreadExecute(webhookID, token, wait, threadID, data, options...)
+}
+
+// WebhookMessageEdit edits the webhook message using the client's session.
+func (c *WebhookClient) WebhookMessageEdit(webhookID, token, messageID string, data *discordgo.WebhookEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	return c.session.WebhookMessageEdit(webhookID, token, messageID, data, options...)
+}
+
+// WebhookMessageDelete deletes the webhook message using the client's session.
+func (c *WebhookClient) WebhookMessageDelete(webhookID, token, messageID string, options ...discordgo.RequestOption) error {
+	return c.session.WebhookMessageDelete(webhookID, token, messageID, options...)
+}
+
+var _ WebhookSender = (*WebhookClient)(nil)
@@ -0,0 +1,121 @@
+package disgomsg
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestParseWebhookURL(t *testing.T) {
+	tests := []struct {
+		url   string
+		id    string
+		token string
+	}{
+		{"https://discord.com/api/webhooks/123456/abc-DEF_789", "123456", "abc-DEF_789"},
+		{"https://discordapp.com/api/webhooks/123456/token", "123456", "token"},
+		{"https://canary.discord.com/api/webhooks/123456/token", "123456", "token"},
+		{"https://discord.com/api/v10/webhooks/123456/token/", "123456", "token"},
+		{" https://discord.com/api/webhooks/123456/token?wait=true ", "123456", "token"},
+	}
+	for _, tt := range tests {
+		id, token, err := ParseWebhookURL(tt.url)
+		if err != nil {
+			t.Errorf("ParseWebhookURL(%q) returned error: %v", tt.url, err)
+			continue
+		}
+		if id != tt.id || token != tt.token {
+			t.Errorf("ParseWebhookURL(%q) = %q, %q; want %q, %q", tt.url, id, token, tt.id, tt.token)
+		}
+	}
+}
+
+func TestParseWebhookURLInvalid(t *testing.T) {
+	invalid := []string{
+		"",
+		"not a url",
+		"http://discord.com/api/webhooks/123456/token",
+		"https://example.com/api/webhooks/123456/token",
+		"https://discord.com/api/webhooks/123456",
+		"https://discord.com/api/webhooks/abc/token",
+		"https://discord.com/api/webhooks/123456/token/extra",
+		"https://discord.com/api/channels/123456/token",
+		"https://discord.com/api/webhooks/123456/to%20ken",
+	}
+	for _, u := range invalid {
+		if _, _, err := ParseWebhookURL(u); !errors.Is(err, ErrInvalidWebhookURL) {
+			t.Errorf("ParseWebhookURL(%q): expected ErrInvalidWebhookURL, got %v", u, err)
+		}
+	}
+	if _, err := NewWebhookClient("https://example.com/hook"); !errors.Is(err, ErrInvalidWebhookURL) {
+		t.Errorf("NewWebhookClient: expected ErrInvalidWebhookURL, got %v", err)
+	}
+}
+
+func TestWebhookClientSendEditDelete(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests []string
+	)
+	s := newFakeDiscord(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Errorf("Expected no authorization header, got %q", auth)
+		}
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		respond(w, http.StatusOK, `{"id": "message-1", "channel_id": "channel-1"}`)
+	})
+
+	client, err := NewWebhookClient("https://discord.com/api/webhooks/123456/token")
+	if err != nil {
+		t.Fatalf("NewWebhookClient returned error: %v", err)
+	}
+	if client.WebhookID() != "123456" {
+		t.Errorf("Expected webhook ID 123456, got %q", client.WebhookID())
+	}
+	client.Session().Client = s.Client
+
+	msg, err := client.Send(WithContent("hello"), WithUsername("Reporter"))
+	if err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if msg.messageID != "message-1" {
+		t.Errorf("Expected message ID message-1, got %q", msg.messageID)
+	}
+	if err := msg.WithContent("updated").Edit(client); err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+	if err := msg.Delete(client); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+
+	base := "/api/v" + discordgo.APIVersion + "/webhooks/123456/token"
+	want := []string{
+		"POST " + base,
+		"PATCH " + base + "/messages/message-1",
+		"DELETE " + base + "/messages/message-1",
+	}
+	if strings.Join(requests, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Expected requests %q, got %q", want, requests)
+	}
+}
+
+func TestWebhookClientNewMessage(t *testing.T) {
+	client, err := NewWebhookClient("https://discord.com/api/webhooks/123456/token")
+	if err != nil {
+		t.Fatalf("NewWebhookClient returned error: %v", err)
+	}
+	msg := client.NewMessage(WithContent("hello"))
+	if msg.webhookID != "123456" || msg.webhookToken != "token" {
+		t.Errorf("Expected message bound to the webhook, got %q, %q", msg.webhookID, msg.webhookToken)
+	}
+}